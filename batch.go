@@ -0,0 +1,340 @@
+package openfigi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// Search every item with query, running at most parallelism searches at once.
+// Responses are in the same order as items; a failed search leaves a zero
+// [SearchResponse] at its index and its error is joined into the returned error.
+//
+// Usage:
+//
+//	res, err := SearchBatch(ctx, []BaseItem{au, us}, "BHP", 2)
+func SearchBatch(ctx context.Context, items []BaseItem, query string, parallelism int) ([]SearchResponse, error) {
+	return runBatch(items, parallelism, func(item BaseItem) (SearchResponse, error) {
+		return item.search(ctx, query, FirstPage)
+	})
+}
+
+// Filter every item with query, first page only, and sum their totals, e.g.
+// to count instruments across exchanges. Filters run one at a time; a failed
+// filter leaves a zero [FilterResponse] at its index, adds nothing to
+// grandTotal and its error is joined into err.
+//
+// Usage:
+//
+//	_, total, err := FilterBatch(ctx, []BaseItem{{ExchCode: "US"}, {ExchCode: "AU"}}, "BHP")
+func FilterBatch(ctx context.Context, items []BaseItem, query string) (perItem []FilterResponse, grandTotal int, err error) {
+	perItem, err = runBatch(items, 1, func(item BaseItem) (FilterResponse, error) {
+		return item.filter(ctx, query, FirstPage)
+	})
+	for _, res := range perItem {
+		grandTotal += res.Total
+	}
+	return
+}
+
+// Call f on every item, running at most parallelism calls at once. Results are
+// in the same order as items; failures leave a zero value and are joined, naming their index.
+func runBatch[T any](items []BaseItem, parallelism int, f func(BaseItem) (T, error)) ([]T, error) {
+	res := make([]T, len(items))
+	errs := make([]error, len(items))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range max(parallelism, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if r, err := f(items[i]); err != nil {
+					errs[i] = fmt.Errorf("item %d: %w", i, err)
+				} else {
+					res[i] = r
+				}
+			}
+		}()
+	}
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return res, errors.Join(errs...)
+}
+
+// Jobs the API accepts per mapping request: 100 with an API key, 10 without.
+// [MappingRequest.FetchAll] chunks by it.
+//
+// Usage:
+//
+//	for chunk := range slices.Chunk(req, MaxMappingJobs()) {
+//		queue <- chunk
+//	}
+func MaxMappingJobs() int {
+	if APIKey() != "" {
+		return 100
+	}
+	return 10
+}
+
+// Chunk of a request, with the index of its first item in the request
+type mappingChunk struct {
+	start int
+	req   MappingRequest
+}
+
+// Items of the chunk as a range of the request, e.g. "items 10-19"
+func (c mappingChunk) String() string {
+	return fmt.Sprintf("items %d-%d", c.start, c.start+len(c.req)-1)
+}
+
+// Split the request into chunks the API accepts, reading [MaxMappingJobs] once
+func (m_req MappingRequest) chunks() []mappingChunk {
+	var chunks []mappingChunk
+	size := MaxMappingJobs()
+	for start := 0; start < len(m_req); start += size {
+		chunks = append(chunks, mappingChunk{start, m_req[start:min(start+size, len(m_req))]})
+	}
+	return chunks
+}
+
+// Fetch the request, and on a 413 halve it and fetch each half the same way,
+// down to single items, e.g. when the API key stops being accepted mid-run.
+// When a half fails, the responses so far are returned with the error, one
+// per item: items not fetched carry the error in their Error field.
+func (m_req MappingRequest) fetchSplitting(ctx context.Context) ([]SingleMappingResponse, error) {
+	res, err := m_req.fetch(ctx)
+	var apiErr *APIError
+	if len(m_req) == 1 || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusRequestEntityTooLarge {
+		return res, err
+	}
+	half := len(m_req) / 2
+	logger().Debug(fmt.Sprintf("413 — splitting %d items into %d and %d", len(m_req), half, len(m_req)-half))
+	first, err := m_req[:half].fetchSplitting(ctx)
+	if err != nil {
+		return padFailed(first, len(m_req), err), err
+	}
+	second, err := m_req[half:].fetchSplitting(ctx)
+	return padFailed(append(first, second...), len(m_req), err), err
+}
+
+// Pad res to n responses carrying err, unless err is nil
+func padFailed(res []SingleMappingResponse, n int, err error) []SingleMappingResponse {
+	if err == nil {
+		return res
+	}
+	for len(res) < n {
+		res = append(res, SingleMappingResponse{Error: err.Error()})
+	}
+	return res
+}
+
+// Validate every item and count the chunks [MappingRequest.FetchAll] would
+// send with the current API key, without calling the API.
+// errs joins the validation error of each invalid item, naming its index.
+func (m_req MappingRequest) DryRun() (chunks int, errs error) {
+	size := MaxMappingJobs()
+	return (len(m_req) + size - 1) / size, m_req.validateItems()
+}
+
+// Validation errors of every invalid item, naming its index
+func (m_req MappingRequest) validateItems() error {
+	var errs []error
+	for i, item := range m_req {
+		if err := item.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate every item under [ValidateBeforeFetch]
+func (m_req MappingRequest) prevalidate() error {
+	if !ValidateBeforeFetch() {
+		return nil
+	}
+	return m_req.validateItems()
+}
+
+// Fetch a request of any size, one chunk at a time.
+// A chunk rejected with a 413 is split in halves until the API accepts them.
+//
+// Failed chunks do not stop the others. The result holds the responses of
+// the successful chunks in request order, skipping failed chunks but for a
+// chunk split on a 413: its fetched part is kept, and its other items carry
+// the error in their Error field. The error joins one error per failed chunk,
+// naming its index, item range and status.
+//
+// Usage:
+//
+//	res, err := req.FetchAll(ctx)
+//	if err != nil {
+//		slog.Warn("partial mapping", "err", err)
+//	}
+func (m_req MappingRequest) FetchAll(ctx context.Context) ([]SingleMappingResponse, error) {
+	return m_req.FetchAllConcurrent(ctx, 1)
+}
+
+// [MappingRequest.FetchAll] with at most parallelism chunks in flight.
+// The result keeps request order regardless of completion order.
+func (m_req MappingRequest) FetchAllConcurrent(ctx context.Context, parallelism int) ([]SingleMappingResponse, error) {
+	return m_req.FetchAllProgress(ctx, parallelism, nil)
+}
+
+// Called after each chunk of [MappingRequest.FetchAllProgress] completes,
+// with the chunk index, the number of chunks, the index in the request of
+// the chunk's first item, and the chunk result or error.
+type OnChunk func(index, total, start int, res []SingleMappingResponse, err error)
+
+// [MappingRequest.FetchAllConcurrent] calling onChunk after each chunk, e.g. to
+// update a progress bar or checkpoint results. Calls never overlap; they are in
+// chunk order when parallelism is 1 and in completion order otherwise.
+// onChunk runs on the fetching goroutines, so a slow callback slows the fetch.
+//
+// Usage:
+//
+//	res, err := req.FetchAllProgress(ctx, 4, func(index, total, _ int, _ []SingleMappingResponse, _ error) {
+//		fmt.Printf("\r%d/%d chunks", index+1, total)
+//	})
+func (m_req MappingRequest) FetchAllProgress(ctx context.Context, parallelism int, onChunk OnChunk) ([]SingleMappingResponse, error) {
+	if err := m_req.prevalidate(); err != nil {
+		return nil, err
+	}
+	chunks := m_req.chunks()
+	chunkRes := make([][]SingleMappingResponse, len(chunks))
+	errs := make([]error, len(chunks))
+	fetchChunks(ctx, chunks, parallelism, func(i int, res []SingleMappingResponse, err error) {
+		chunkRes[i], errs[i] = res, err
+		if onChunk != nil {
+			onChunk(i, len(chunks), chunks[i].start, res, err)
+		}
+	})
+	return slices.Concat(chunkRes...), errors.Join(errs...)
+}
+
+// Fetch the chunks with at most parallelism in flight, calling done after each
+// with its result or an error naming it. Calls of done never overlap.
+func fetchChunks(ctx context.Context, chunks []mappingChunk, parallelism int, done func(i int, res []SingleMappingResponse, err error)) {
+	var doneMu sync.Mutex
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range max(parallelism, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				res, err := chunks[i].req.fetchSplitting(ctx)
+				if err != nil {
+					err = fmt.Errorf("chunk %d (%v): %w", i, chunks[i], err)
+				}
+				doneMu.Lock()
+				done(i, res, err)
+				doneMu.Unlock()
+			}
+		}()
+	}
+	for i := range chunks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// Responses of a request of any size, sent chunk by chunk as they are fetched.
+// A failed chunk sends one error, as by [MappingRequest.FetchAll], and does not
+// stop the others. The channel is closed after the last chunk or once ctx is
+// done; to stop reading early, cancel ctx and the producing goroutine exits.
+//
+// Usage:
+//
+//	ctx, cancel := context.WithCancel(ctx)
+//	defer cancel()
+//	for r := range req.FetchStream(ctx) {
+//		if r.Err != nil {
+//			slog.Warn("chunk failed", "err", r.Err)
+//			continue
+//		}
+//		fmt.Println(r.Value.Data)
+//	}
+func (m_req MappingRequest) FetchStream(ctx context.Context) <-chan StreamResult[SingleMappingResponse] {
+	results := make(chan StreamResult[SingleMappingResponse])
+	go func() {
+		defer close(results)
+		if err := m_req.prevalidate(); err != nil {
+			sendResult(ctx, results, StreamResult[SingleMappingResponse]{Err: err})
+			return
+		}
+		for i, chunk := range m_req.chunks() {
+			if ctx.Err() != nil {
+				return
+			}
+			res, err := chunk.req.fetchSplitting(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				err = fmt.Errorf("chunk %d (%v): %w", i, chunk, err)
+				if !sendResult(ctx, results, StreamResult[SingleMappingResponse]{Err: err}) {
+					return
+				}
+				continue
+			}
+			for _, r := range res {
+				if !sendResult(ctx, results, StreamResult[SingleMappingResponse]{Value: r}) {
+					return
+				}
+			}
+		}
+	}()
+	return results
+}
+
+// Map many values of one idType under the same constraints, e.g. a venue.
+// Every item is validated first, and nothing is sent if one is invalid;
+// the request is then chunked and fetched as by [MappingRequest.FetchAll].
+//
+// The result is aligned with values: the response to values[i] is at index i.
+// Items of a failed chunk get the chunk's error in their Error field, and the
+// error joins one error per failed chunk, as by [MappingRequest.FetchAll].
+//
+// Usage:
+//
+//	res, err := MapBatch(ctx, constants.IDTYPE_TICKER, []any{"AAPL", "MSFT"}, BaseItem{ExchCode: constants.EXCHCODE_US})
+func MapBatch(ctx context.Context, idType IDType, values []any, venue BaseItem) ([]SingleMappingResponse, error) {
+	req := make(MappingRequest, len(values))
+	var errs []error
+	for i, value := range values {
+		item, err := venue.AsMappingItem(idType, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+		}
+		req[i] = item
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	res := make([]SingleMappingResponse, len(values))
+	chunks := req.chunks()
+	fetchChunks(ctx, chunks, 1, func(i int, chunkRes []SingleMappingResponse, err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for j := range chunks[i].req {
+			if j < len(chunkRes) {
+				res[chunks[i].start+j] = chunkRes[j]
+			} else if err != nil {
+				res[chunks[i].start+j].Error = err.Error()
+			}
+		}
+	})
+	return res, errors.Join(errs...)
+}
@@ -1,17 +1,41 @@
 package openfigi
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/minh-dng/openfigi-go/constants"
 	"golang.org/x/exp/constraints"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // ========================= BASE ITEM =========================
 
 type BaseItemBuilder struct {
-	item BaseItem
+	item              BaseItem
+	normalize         bool
+	requireConstraint bool
+	rangeErrs         [len(rangeFields)]error // Bad ranges given to setters
+}
+
+// Normalize `exchCode`, `micCode` and `currency` on [BaseItemBuilder.Build].
+// Surrounding whitespace is trimmed, then the value is uppercased unless
+// it is already a known value. Mixed-case values such as "GBp" (pence) or
+// "Aquis" are therefore kept as is, while "aud " becomes "AUD".
+// Other fields are case-sensitive and never normalized.
+func (b *BaseItemBuilder) SetNormalization(normalize bool) *BaseItemBuilder {
+	b.normalize = normalize
+	return b
 }
 
 func (b *BaseItemBuilder) SetExchCode(exchCode string) *BaseItemBuilder {
@@ -24,6 +48,29 @@ func (b *BaseItemBuilder) SetMicCode(micCode string) *BaseItemBuilder {
 	return b
 }
 
+// Kind of venue code, see [BaseItemBuilder.SetVenue]
+type VenueKind int
+
+const (
+	VenueExchange VenueKind = iota // `exchCode`
+	VenueMIC                       // `micCode`
+)
+
+// Set `exchCode` or `micCode` and clear the other, as they cannot be used together
+//
+// Usage:
+//
+//	builder.SetVenue(VenueMIC, constants.MICCODE_XNAS)
+func (b *BaseItemBuilder) SetVenue(kind VenueKind, code string) *BaseItemBuilder {
+	switch kind {
+	case VenueExchange:
+		b.item.ExchCode, b.item.MicCode = code, ""
+	case VenueMIC:
+		b.item.ExchCode, b.item.MicCode = "", code
+	}
+	return b
+}
+
 func (b *BaseItemBuilder) SetCurrency(currency string) *BaseItemBuilder {
 	b.item.Currency = currency
 	return b
@@ -58,8 +105,7 @@ func (b *BaseItemBuilder) SetOptionType(optionType string) *BaseItemBuilder {
 //
 //	builder.SetStrike([2]any{nil, 2.0})
 func (b *BaseItemBuilder) SetStrike(strike [2]any) *BaseItemBuilder {
-	strikeRange := intepretRange[float64](strike)
-	b.item.Strike = &strikeRange
+	setRange(b, "strike", &b.item.Strike, strike)
 	return b
 }
 
@@ -67,8 +113,7 @@ func (b *BaseItemBuilder) SetStrike(strike [2]any) *BaseItemBuilder {
 //
 //	builder.SetContractSize([2]any{2.0, nil})
 func (b *BaseItemBuilder) SetContractSize(contractSize [2]any) *BaseItemBuilder {
-	contractSizeRange := intepretRange[float64](contractSize)
-	b.item.ContractSize = &contractSizeRange
+	setRange(b, "contractSize", &b.item.ContractSize, contractSize)
 	return b
 }
 
@@ -76,8 +121,7 @@ func (b *BaseItemBuilder) SetContractSize(contractSize [2]any) *BaseItemBuilder
 //
 //	builder.SetCoupon([2]any{nil, 2.0})
 func (b *BaseItemBuilder) SetCoupon(coupon [2]any) *BaseItemBuilder {
-	couponRange := intepretRange[float64](coupon)
-	b.item.Coupon = &couponRange
+	setRange(b, "coupon", &b.item.Coupon, coupon)
 	return b
 }
 
@@ -85,8 +129,7 @@ func (b *BaseItemBuilder) SetCoupon(coupon [2]any) *BaseItemBuilder {
 //
 //	builder.SetExpiration([2]any{"2021-01-01", "2022-01-01"})
 func (b *BaseItemBuilder) SetExpiration(expiration [2]any) *BaseItemBuilder {
-	expirationRange := intepretRange[string](expiration)
-	b.item.Expiration = &expirationRange
+	setRange(b, "expiration", &b.item.Expiration, expiration)
 	return b
 }
 
@@ -94,8 +137,7 @@ func (b *BaseItemBuilder) SetExpiration(expiration [2]any) *BaseItemBuilder {
 //
 //	builder.SetMaturity([2]any{nil, "2022-01-01"})
 func (b *BaseItemBuilder) SetMaturity(maturity [2]any) *BaseItemBuilder {
-	maturityRange := intepretRange[string](maturity)
-	b.item.Maturity = &maturityRange
+	setRange(b, "maturity", &b.item.Maturity, maturity)
 	return b
 }
 
@@ -104,51 +146,540 @@ func (b *BaseItemBuilder) SetStateCode(stateCode string) *BaseItemBuilder {
 	return b
 }
 
+// === Typed setters
+// Variants of the setters above taking the types of the constants package,
+// so a string variable needs an explicit conversion to compile.
+// Use the string setters for dynamic values.
+//
+// Usage:
+//
+//	builder.SetExchCodeTyped(constants.EXCHCODE_US)
+
+func (b *BaseItemBuilder) SetExchCodeTyped(exchCode constants.ExchCode) *BaseItemBuilder {
+	return b.SetExchCode(string(exchCode))
+}
+
+func (b *BaseItemBuilder) SetMicCodeTyped(micCode constants.MicCode) *BaseItemBuilder {
+	return b.SetMicCode(string(micCode))
+}
+
+func (b *BaseItemBuilder) SetCurrencyTyped(currency constants.Currency) *BaseItemBuilder {
+	return b.SetCurrency(string(currency))
+}
+
+func (b *BaseItemBuilder) SetMarketSecDesTyped(marketSecDes constants.MarketSecDes) *BaseItemBuilder {
+	return b.SetMarketSecDes(string(marketSecDes))
+}
+
+func (b *BaseItemBuilder) SetSecurityTypeTyped(securityType constants.SecurityType) *BaseItemBuilder {
+	return b.SetSecurityType(string(securityType))
+}
+
+func (b *BaseItemBuilder) SetSecurityType2Typed(securityType2 constants.SecurityType2) *BaseItemBuilder {
+	return b.SetSecurityType2(string(securityType2))
+}
+
+func (b *BaseItemBuilder) SetStateCodeTyped(stateCode constants.StateCode) *BaseItemBuilder {
+	return b.SetStateCode(string(stateCode))
+}
+
+// Query used by [BaseItem.SearchBuilt] and [BaseItem.FilterBuilt].
+// Ignored by mapping requests.
+func (b *BaseItemBuilder) SetQuery(query string) *BaseItemBuilder {
+	b.item.Query = query
+	return b
+}
+
+// Make [BaseItemBuilder.Build] run [BaseItem.ValidateForSearch] with the
+// query from [BaseItemBuilder.SetQuery], rejecting items that match everything.
+func (b *BaseItemBuilder) SetRequireConstraint(require bool) *BaseItemBuilder {
+	b.requireConstraint = require
+	return b
+}
+
+// Setters of the string fields, by JSON key
+var stringSetters = map[string]func(*BaseItemBuilder, string) *BaseItemBuilder{
+	"exchCode":      (*BaseItemBuilder).SetExchCode,
+	"micCode":       (*BaseItemBuilder).SetMicCode,
+	"currency":      (*BaseItemBuilder).SetCurrency,
+	"marketSecDes":  (*BaseItemBuilder).SetMarketSecDes,
+	"securityType":  (*BaseItemBuilder).SetSecurityType,
+	"securityType2": (*BaseItemBuilder).SetSecurityType2,
+	"optionType":    (*BaseItemBuilder).SetOptionType,
+	"stateCode":     (*BaseItemBuilder).SetStateCode,
+	"query":         (*BaseItemBuilder).SetQuery,
+}
+
+// Setters of the range fields, by JSON key
+var rangeSetters = map[string]func(*BaseItemBuilder, [2]any) *BaseItemBuilder{
+	"strike":       (*BaseItemBuilder).SetStrike,
+	"contractSize": (*BaseItemBuilder).SetContractSize,
+	"coupon":       (*BaseItemBuilder).SetCoupon,
+	"expiration":   (*BaseItemBuilder).SetExpiration,
+	"maturity":     (*BaseItemBuilder).SetMaturity,
+}
+
+// Set fields from a map keyed by their JSON name, e.g. from a config file.
+// Accepted keys and values:
+//   - exchCode, micCode, currency, marketSecDes, securityType, securityType2,
+//     optionType, stateCode and query: a string
+//   - includeUnlistedEquities: a bool
+//   - strike, contractSize, coupon, expiration and maturity: a [2]any or a
+//     2-element []any, as taken by [BaseItemBuilder.SetStrike] and the like;
+//     integer bounds of strike, contractSize and coupon are taken as float64
+//
+// Every key is applied; the error joins one error per unknown key, value of
+// the wrong type or bad range, in key order.
+//
+// Usage:
+//
+//	err := builder.SetFields(map[string]any{"exchCode": "US", "strike": []any{100, nil}})
+func (b *BaseItemBuilder) SetFields(m map[string]any) error {
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if err := b.setField(key, m[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *BaseItemBuilder) setField(key string, value any) error {
+	if set, ok := stringSetters[key]; ok {
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("`%s` must be a string, got %T", key, value)
+		}
+		set(b, s)
+		return nil
+	}
+	if set, ok := rangeSetters[key]; ok {
+		var bounds [2]any
+		switch v := value.(type) {
+		case [2]any:
+			bounds = v
+		case []any:
+			if len(v) != 2 {
+				return fmt.Errorf("`%s` must have 2 bounds, got %d", key, len(v))
+			}
+			bounds = [2]any(v)
+		default:
+			return fmt.Errorf("`%s` must be a range of 2 bounds, got %T", key, value)
+		}
+		if key != "expiration" && key != "maturity" {
+			bounds = [2]any{floatBound(bounds[0]), floatBound(bounds[1])}
+		}
+		set(b, bounds)
+		return b.rangeErrs[slices.Index(rangeFields[:], key)]
+	}
+	if key == "includeUnlistedEquities" {
+		include, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("`%s` must be a bool, got %T", key, value)
+		}
+		b.SetIncludeUnlistedEquities(include)
+		return nil
+	}
+	return fmt.Errorf("unknown field `%s`", key)
+}
+
+// An integer bound as float64, as a config file may hold 100 for 100.0;
+// other bounds as is
+func floatBound(bound any) any {
+	switch v := reflect.ValueOf(bound); {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	}
+	return bound
+}
+
+// Run the checks of Build() on the item so far, without building it,
+// e.g. to validate a form on each keystroke
+func (b *BaseItemBuilder) Validate() error {
+	_, err := b.Build()
+	return err
+}
+
 func (b *BaseItemBuilder) Build() (item BaseItem, err error) {
-	item = b.item
-	err = item.validate()
+	defer func() { logValidationErr(item, err) }()
+	item = b.built()
+	if err = b.rangeErr(); err != nil {
+		return
+	}
+	if b.requireConstraint {
+		err = item.ValidateForSearch(item.Query)
+	} else {
+		err = item.validate()
+	}
 	return
 }
 
+// The in-progress item, normalized if enabled
+func (b *BaseItemBuilder) built() BaseItem {
+	item := b.item.clone()
+	if b.normalize {
+		item.ExchCode = normalizeCode(item.ExchCode, exchCodeSet)
+		item.MicCode = normalizeCode(item.MicCode, micCodeSet)
+		item.Currency = normalizeCode(item.Currency, currencySet)
+	}
+	return item
+}
+
+// Build the item, then search the first page with query.
+// Returns the validation error if the item is invalid.
+//
+// Usage:
+//
+//	builder := BaseItem{}.GetBuilder()
+//	builder.SetExchCode("US")
+//	res, err := builder.Search(ctx, "apple")
+func (b *BaseItemBuilder) Search(ctx context.Context, query string) (res SearchResponse, err error) {
+	item, err := b.Build()
+	if err != nil {
+		return
+	}
+	item.Query = query
+	return item.SearchBuilt(ctx)
+}
+
+// Build the item, then filter the first page with query.
+// Returns the validation error if the item is invalid.
+//
+// Usage:
+//
+//	builder := BaseItem{}.GetBuilder()
+//	builder.SetExchCode("US")
+//	res, err := builder.Filter(ctx, "apple")
+func (b *BaseItemBuilder) Filter(ctx context.Context, query string) (res FilterResponse, err error) {
+	item, err := b.Build()
+	if err != nil {
+		return
+	}
+	item.Query = query
+	return item.FilterBuilt(ctx)
+}
+
+// ========================= UNSETTERS =========================
+
+// Unsetters reset a field to its zero value, for reusing a builder.
+
+func (b *BaseItemBuilder) UnsetExchCode() *BaseItemBuilder {
+	b.item.ExchCode = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetMicCode() *BaseItemBuilder {
+	b.item.MicCode = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetCurrency() *BaseItemBuilder {
+	b.item.Currency = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetMarketSecDes() *BaseItemBuilder {
+	b.item.MarketSecDes = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetSecurityType() *BaseItemBuilder {
+	b.item.SecurityType = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetSecurityType2() *BaseItemBuilder {
+	b.item.SecurityType2 = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetIncludeUnlistedEquities() *BaseItemBuilder {
+	b.item.IncludeUnlistedEquities = false
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetOptionType() *BaseItemBuilder {
+	b.item.OptionType = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetStrike() *BaseItemBuilder {
+	b.item.Strike = nil
+	b.setRangeErr("strike", nil)
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetContractSize() *BaseItemBuilder {
+	b.item.ContractSize = nil
+	b.setRangeErr("contractSize", nil)
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetCoupon() *BaseItemBuilder {
+	b.item.Coupon = nil
+	b.setRangeErr("coupon", nil)
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetExpiration() *BaseItemBuilder {
+	b.item.Expiration = nil
+	b.setRangeErr("expiration", nil)
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetMaturity() *BaseItemBuilder {
+	b.item.Maturity = nil
+	b.setRangeErr("maturity", nil)
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetStateCode() *BaseItemBuilder {
+	b.item.StateCode = ""
+	return b
+}
+
+func (b *BaseItemBuilder) UnsetQuery() *BaseItemBuilder {
+	b.item.Query = ""
+	return b
+}
+
 // ========================= MAPPING ITEM =========================
 
 type MappingItemBuilder struct {
 	BaseItemBuilder
-	item MappingItem
+	item   MappingItem
+	values []any // Fan-out of `idValue` for BuildMany()
+}
+
+func (m *MappingItemBuilder) SetValue(value string) *MappingItemBuilder {
+	m.item.Value = value
+	return m
+}
+
+// Integer `idValue`, only valid for the idTypes in [NumericIDTypes]
+func (m *MappingItemBuilder) SetIntValue(value int64) *MappingItemBuilder {
+	m.item.Value = value
+	return m
 }
 
 func (m *MappingItemBuilder) Build() (item MappingItem, err error) {
-	m.item.BaseItem = m.BaseItemBuilder.item
+	defer func() { logValidationErr(item, err) }()
+	m.item.BaseItem = m.BaseItemBuilder.built()
 
 	item = m.item
-	err = m.item.validate()
+	if err = m.rangeErr(); err != nil {
+		return
+	}
+	err = item.validate()
 	return
 }
 
+// Run the checks of Build() on the item so far, without building it
+func (m *MappingItemBuilder) Validate() error {
+	_, err := m.Build()
+	return err
+}
+
+// Several `idValue`s sharing the same idType and constraints, see [MappingItemBuilder.BuildMany]
+//
+// Usage:
+//
+//	builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, nil)
+//	builder.SetExchCode(constants.EXCHCODE_US)
+//	req, err := builder.SetValues("AAPL", "MSFT", "IBM").BuildMany()
+func (m *MappingItemBuilder) SetValues(values ...any) *MappingItemBuilder {
+	m.values = slices.Clone(values)
+	return m
+}
+
+// One [MappingItem] per value given to SetValues(), each validated on its own.
+// Without SetValues(), the request holds the single built item.
+func (m *MappingItemBuilder) BuildMany() (MappingRequest, error) {
+	if m.values == nil {
+		item, err := m.Build()
+		return MappingRequest{item}, err
+	}
+	if err := m.rangeErr(); err != nil {
+		return nil, err
+	}
+
+	req := make(MappingRequest, len(m.values))
+	var errs []error
+	for i, value := range m.values {
+		req[i] = MappingItem{
+			Type:     m.item.Type,
+			Value:    value,
+			BaseItem: m.BaseItemBuilder.built(),
+		}
+		if err := req[i].validate(); err != nil {
+			logValidationErr(req[i], err)
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+		}
+	}
+	return req, errors.Join(errs...)
+}
+
 // ========================= AUXILIARY FUNC =========================
 
-// Make sure the range is of the right type. Will panic if not.
+// Make sure the range is of the right type. Errors if not.
 // If float, nil will be replaced with -Inf or Inf.
 // If string, nil will be replaced with "".
-func intepretRange[T constraints.Ordered](interval [2]interface{}) interval[T] {
+func intepretRange[T constraints.Ordered](bounds [2]interface{}) (interval[T], error) {
 	var zero T
 	switch any(zero).(type) {
 	case float64:
-		if interval[0] == nil {
-			interval[0] = math.Inf(-1)
+		if bounds[0] == nil {
+			bounds[0] = math.Inf(-1)
 		}
-		if interval[1] == nil {
-			interval[1] = math.Inf(1)
+		if bounds[1] == nil {
+			bounds[1] = math.Inf(1)
 		}
 	case string:
-		if interval[0] == nil {
-			interval[0] = ""
+		if bounds[0] == nil {
+			bounds[0] = ""
 		}
-		if interval[1] == nil {
-			interval[1] = ""
+		if bounds[1] == nil {
+			bounds[1] = ""
 		}
 	}
-	return [2]T{interval[0].(T), interval[1].(T)}
+	start, okStart := bounds[0].(T)
+	end, okEnd := bounds[1].(T)
+	if !okStart || !okEnd {
+		return interval[T]{}, fmt.Errorf("bounds must be %T or nil, got %T and %T", zero, bounds[0], bounds[1])
+	}
+	return interval[T]{start, end}, nil
+}
+
+// Interpret the range into dst, or keep the error for Build()
+func setRange[T constraints.Ordered](b *BaseItemBuilder, field string, dst **interval[T], bounds [2]any) {
+	r, err := intepretRange[T](bounds)
+	if err != nil {
+		*dst = nil
+		b.setRangeErr(field, fmt.Errorf("bad `%s`: %w", field, err))
+		return
+	}
+	*dst = &r
+	b.setRangeErr(field, nil)
+}
+
+// Range fields, in the order of BaseItemBuilder.rangeErrs
+var rangeFields = [...]string{"strike", "contractSize", "coupon", "expiration", "maturity"}
+
+func (b *BaseItemBuilder) setRangeErr(field string, err error) {
+	b.rangeErrs[slices.Index(rangeFields[:], field)] = err
+}
+
+// Errors of the bad ranges given to setters, in field order
+func (b *BaseItemBuilder) rangeErr() error {
+	return errors.Join(b.rangeErrs[:]...)
+}
+
+// First `field` named by a validation error
+var errFieldRe = regexp.MustCompile("`([A-Za-z0-9]+)`")
+
+// Debug log of a failed Build() under [LogValidation], with the field named by
+// err and its value in item
+func logValidationErr(item any, err error) {
+	if err == nil || !LogValidation() {
+		return
+	}
+	attrs := []any{"err", err}
+	if match := errFieldRe.FindStringSubmatch(err.Error()); match != nil {
+		attrs = append(attrs, "field", match[1])
+		var fields map[string]any
+		if data, jsonErr := json.Marshal(item); jsonErr == nil && json.Unmarshal(data, &fields) == nil {
+			attrs = append(attrs, "value", fields[match[1]])
+		}
+	}
+	logger().Debug("validation failed", attrs...)
+}
+
+// Parse a YYYY-MM-DD date, rejecting any input that does not format back to itself
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return date, err
+	}
+	if formatted := date.Format(time.DateOnly); formatted != value {
+		return date, fmt.Errorf("%q is not a valid date, did you mean %q?", value, formatted)
+	}
+	return date, nil
+}
+
+// Trim the value and uppercase it, unless it is already a known value.
+func normalizeCode(value string, known sets.Set[string]) string {
+	value = strings.TrimSpace(value)
+	if value == "" || known.Has(value) {
+		return value
+	}
+	return strings.ToUpper(value)
+}
+
+// Encode open bounds (Inf or "") as null, and numbers in fixed decimal
+// notation under [FixedDecimalBounds]. NaN bounds have no encoding.
+func (interval interval[T]) MarshalJSON() ([]byte, error) {
+	var bounds [2]any
+	for i, bound := range interval {
+		if isOpenBound(bound) {
+			continue
+		}
+		bounds[i] = bound
+		if v, ok := any(bound).(float64); ok {
+			if math.IsNaN(v) {
+				return nil, fmt.Errorf("bound %d is NaN", i)
+			}
+			if FixedDecimalBounds() && math.Abs(v) < 1e21 {
+				bounds[i] = json.Number(strconv.FormatFloat(v, 'f', -1, 64))
+			}
+		}
+	}
+	return json.Marshal(bounds)
+}
+
+// Decode [a, b], [a, null] or [null, b], the inverse of MarshalJSON
+func (interval *interval[T]) UnmarshalJSON(data []byte) error {
+	var bounds [2]*T
+	if err := json.Unmarshal(data, &bounds); err != nil {
+		return err
+	}
+	var raw [2]any
+	for i, bound := range bounds {
+		if bound != nil {
+			raw[i] = *bound
+		}
+	}
+	decoded, err := intepretRange[T](raw)
+	if err != nil {
+		return err
+	}
+	*interval = decoded
+	return nil
+}
+
+// Reject negative finite bounds, nil intervals are valid
+func (interval *interval[T]) validateNonNegative(field string) error {
+	if interval == nil {
+		return nil
+	}
+	for _, bound := range interval {
+		if v, ok := any(bound).(float64); ok && !math.IsInf(v, 0) && v < 0 {
+			return fmt.Errorf("`%s` cannot be negative, got %v", field, v)
+		}
+	}
+	return nil
+}
+
+// Whether the bound stands for null: Inf for numbers, "" for dates
+func isOpenBound[T constraints.Ordered](bound T) bool {
+	switch v := any(bound).(type) {
+	case float64:
+		return math.IsInf(v, 0)
+	case string:
+		return v == ""
+	}
+	return false
 }
 
 // Validate the interval. The bound must be in the right order, no both nils.
@@ -158,6 +689,9 @@ func (interval interval[T]) validate() error {
 	case float64:
 		start, _ := any(interval[0]).(float64)
 		end, _ := any(interval[1]).(float64)
+		if math.IsNaN(start) || math.IsNaN(end) {
+			return fmt.Errorf("bad interval: NaN bound in [%v, %v]", start, end)
+		}
 		if math.IsInf(start, -1) && math.IsInf(end, 1) {
 			return fmt.Errorf("interval cannot be [null, null]")
 		} else {
@@ -171,9 +705,9 @@ func (interval interval[T]) validate() error {
 		if start == "" && end == "" {
 			return fmt.Errorf("interval cannot be [null, null]")
 		} else {
-			if s, err := time.Parse(time.DateOnly, start); start != "" && err != nil {
+			if s, err := parseDate(start); start != "" && err != nil {
 				return fmt.Errorf("bad date format: %v", err)
-			} else if e, err := time.Parse(time.DateOnly, end); end != "" && err != nil {
+			} else if e, err := parseDate(end); end != "" && err != nil {
 				return fmt.Errorf("bad date format: %v", err)
 			} else if start != "" && end != "" && s.After(e) {
 				return fmt.Errorf("bad interval: %v > %v", s, e)
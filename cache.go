@@ -0,0 +1,147 @@
+package openfigi
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Response cache, keyed by request. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, val []byte, ttl time.Duration)
+}
+
+type cacheConfig struct {
+	cache Cache
+	ttl   time.Duration
+}
+
+// 💾 CACHE
+var responseCache mutexStruct[cacheConfig]
+
+// Serve responses of identical requests (same base URL, API key, endpoint and
+// body) from cache, keeping them for ttl. Only successful responses are
+// cached. nil disables caching.
+//
+// Usage:
+//
+//	SetCache(NewLRUCache(1000), time.Hour)
+func SetCache(cache Cache, ttl time.Duration) {
+	responseCache.Lock()
+	defer responseCache.Unlock()
+	responseCache.value = cacheConfig{cache, ttl}
+}
+
+func getCache() (Cache, time.Duration) {
+	responseCache.RLock()
+	defer responseCache.RUnlock()
+	return responseCache.value.cache, responseCache.value.ttl
+}
+
+// Cache key of a request
+func cacheKey(endpoint string, payload []byte) string {
+	sum := sha256.Sum256(append([]byte(endpoint+"\n"), payload...))
+	return hex.EncodeToString(sum[:])
+}
+
+// Cache key of a request sent with cfg: the base URL and a hash of the API
+// key are part of it, so a response is not served to another server or key
+func responseCacheKey(cfg config, method, endpoint string, payload []byte) string {
+	keySum := sha256.Sum256([]byte(cfg.apiKey))
+	return cacheKey(cfg.baseURL+"\n"+hex.EncodeToString(keySum[:])+"\n"+method+" "+endpoint, payload)
+}
+
+// Hex SHA-256 of the endpoint and JSON body of the request that produced this
+// page, without its `start` cursor, so every page of a query shares the key
+// while a search and a filter of the same query do not. The query is hashed
+// as given, whatever [SetSanitizeQueries], so the key is stable across process
+// runs with the same endpoints, e.g. to key a cache of your own; empty if the
+// request cannot be marshaled.
+//
+// Usage:
+//
+//	res, _ := item.Search("CRYP", FirstPage)
+//	store[res.RequestKey()] = res.Data
+func (searchRes SearchResponse) RequestKey() string {
+	payload, err := json.Marshal(SearchRequest{BaseItem: searchRes.baseitem, Query: searchRes.query})
+	if err != nil {
+		return ""
+	}
+	return cacheKey("POST "+searchRes.endpoint, payload)
+}
+
+// ========================= LRU CACHE =========================
+
+// In-memory [Cache] evicting the least recently used entry when full
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // Front is the most recently used
+	entries  map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	val     []byte
+	expires time.Time // Zero if it never expires
+}
+
+// LRU cache holding at most capacity entries
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: max(capacity, 1),
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.val, true
+}
+
+// Store val for ttl, forever if ttl <= 0
+func (c *LRUCache) Set(key string, val []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = &lruEntry{key, val, expires}
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key, val, expires})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Number of entries, including expired ones not yet evicted
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,77 @@
+package constants
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCounts(t *testing.T) {
+	counts := map[string]struct {
+		count int
+		empty bool
+	}{
+		"idType":        {IDTypeCount(), IDTypeIsEmpty()},
+		"exchCode":      {ExchCodeCount(), ExchCodeIsEmpty()},
+		"micCode":       {MicCodeCount(), MicCodeIsEmpty()},
+		"currency":      {CurrencyCount(), CurrencyIsEmpty()},
+		"marketSecDes":  {MarketSecDesCount(), MarketSecDesIsEmpty()},
+		"securityType":  {SecurityTypeCount(), SecurityTypeIsEmpty()},
+		"securityType2": {SecurityType2Count(), SecurityType2IsEmpty()},
+		"stateCode":     {StateCodeCount(), StateCodeIsEmpty()},
+	}
+	for prop, c := range counts {
+		if c.count == 0 {
+			t.Errorf("Expected %s values, got none", prop)
+		}
+		if c.empty != (c.count == 0) {
+			t.Errorf("Expected %s IsEmpty to be %v with %d values", prop, c.count == 0, c.count)
+		}
+	}
+}
+
+func TestIDTypeRequiresSecurityType2(t *testing.T) {
+	for _, idType := range idTypeValues {
+		want := idType == IDTYPE_BASE_TICKER || idType == IDTYPE_ID_EXCH_SYMBOL
+		if got := IDTypeRequiresSecurityType2(idType); got != want {
+			t.Errorf("%s: expected %v, got %v", idType, want, got)
+		}
+	}
+}
+
+func TestValuesSorted(t *testing.T) {
+	if !slices.IsSorted(ExchCodes()) {
+		t.Errorf("Expected exchCode values to be sorted")
+	}
+	if !slices.IsSorted(SecurityTypes()) {
+		t.Errorf("Expected securityType values to be sorted")
+	}
+}
+
+func TestValueKeys(t *testing.T) {
+	keys := ValueKeys()
+	if len(keys) != 8 || keys[0] != ValueKeyIDType {
+		t.Errorf("Expected the 8 keys from idType, got %v", keys)
+	}
+	keys[0] = ""
+	if ValueKeys()[0] != ValueKeyIDType {
+		t.Errorf("Expected a copy of the keys")
+	}
+}
+
+func TestIDTypes(t *testing.T) {
+	infos := IDTypes()
+	if len(infos) != IDTypeCount() {
+		t.Fatalf("Expected %d idTypes, got %d", IDTypeCount(), len(infos))
+	}
+	for i, info := range infos {
+		if info.Value != idTypeValues[i] {
+			t.Errorf("Expected %s at %d, got %s", idTypeValues[i], i, info.Value)
+		}
+		if _, ok := idTypeDescriptions[info.Value]; !ok {
+			t.Errorf("%s: expected a description", info.Value)
+		}
+	}
+	if i := slices.IndexFunc(infos, func(info IDTypeInfo) bool { return info.Value == IDTYPE_ID_ISIN }); infos[i].Description != "ISIN - International Securities Identification Number" {
+		t.Errorf("Expected the ISIN label, got %q", infos[i].Description)
+	}
+}
@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of currency, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type Currency string
+
 const (
 	CURRENCY_UNKNOWN = "***"
 	CURRENCY_ADP     = "ADP"
@@ -323,11 +329,356 @@ const (
 	CURRENCY_ZMK     = "ZMK"
 	CURRENCY_ZMW     = "ZMW"
 	CURRENCY_ZWD     = "ZWD"
-	CURRENCY_ZWd     = "ZWd"
 	CURRENCY_ZWF     = "ZWF"
 	CURRENCY_ZWG     = "ZWG"
-	CURRENCY_ZWg     = "ZWg"
 	CURRENCY_ZWL     = "ZWL"
 	CURRENCY_ZWN     = "ZWN"
 	CURRENCY_ZWR     = "ZWR"
+	CURRENCY_ZWd     = "ZWd"
+	CURRENCY_ZWg     = "ZWg"
 )
+
+var currencyValues = []string{
+	CURRENCY_UNKNOWN,
+	CURRENCY_ADP,
+	CURRENCY_AED,
+	CURRENCY_AFN,
+	CURRENCY_ALL,
+	CURRENCY_AMD,
+	CURRENCY_ANG,
+	CURRENCY_AOA,
+	CURRENCY_ARS,
+	CURRENCY_ATS,
+	CURRENCY_AUD,
+	CURRENCY_AUd,
+	CURRENCY_AWG,
+	CURRENCY_AZM,
+	CURRENCY_AZN,
+	CURRENCY_BAM,
+	CURRENCY_BBD,
+	CURRENCY_BDT,
+	CURRENCY_BEF,
+	CURRENCY_BGN,
+	CURRENCY_BHD,
+	CURRENCY_BIF,
+	CURRENCY_BMD,
+	CURRENCY_BND,
+	CURRENCY_BOB,
+	CURRENCY_BRL,
+	CURRENCY_BRl,
+	CURRENCY_BSD,
+	CURRENCY_BTN,
+	CURRENCY_BWP,
+	CURRENCY_BWp,
+	CURRENCY_BYN,
+	CURRENCY_BYR,
+	CURRENCY_BYS,
+	CURRENCY_BZD,
+	CURRENCY_CAD,
+	CURRENCY_CAd,
+	CURRENCY_CDF,
+	CURRENCY_CER,
+	CURRENCY_CHF,
+	CURRENCY_CHf,
+	CURRENCY_CLF,
+	CURRENCY_CLP,
+	CURRENCY_CNH,
+	CURRENCY_CNT,
+	CURRENCY_CNY,
+	CURRENCY_COP,
+	CURRENCY_COU,
+	CURRENCY_CRC,
+	CURRENCY_CRS,
+	CURRENCY_CUP,
+	CURRENCY_CVE,
+	CURRENCY_CYP,
+	CURRENCY_CZK,
+	CURRENCY_DEM,
+	CURRENCY_DJF,
+	CURRENCY_DKK,
+	CURRENCY_DOP,
+	CURRENCY_DZD,
+	CURRENCY_ECS,
+	CURRENCY_EEK,
+	CURRENCY_EES,
+	CURRENCY_EGD,
+	CURRENCY_EGP,
+	CURRENCY_ERN,
+	CURRENCY_ESP,
+	CURRENCY_ETB,
+	CURRENCY_EUA,
+	CURRENCY_EUR,
+	CURRENCY_EUr,
+	CURRENCY_FIM,
+	CURRENCY_FJD,
+	CURRENCY_FKP,
+	CURRENCY_FRF,
+	CURRENCY_GBP,
+	CURRENCY_GBp,
+	CURRENCY_GEL,
+	CURRENCY_GHC,
+	CURRENCY_GHS,
+	CURRENCY_GIP,
+	CURRENCY_GLD,
+	CURRENCY_GMD,
+	CURRENCY_GNF,
+	CURRENCY_GRD,
+	CURRENCY_GTQ,
+	CURRENCY_GWP,
+	CURRENCY_GYD,
+	CURRENCY_HKD,
+	CURRENCY_HNL,
+	CURRENCY_HRK,
+	CURRENCY_HTG,
+	CURRENCY_HUF,
+	CURRENCY_IDR,
+	CURRENCY_IEP,
+	CURRENCY_ILS,
+	CURRENCY_ILs,
+	CURRENCY_INR,
+	CURRENCY_IQD,
+	CURRENCY_IRR,
+	CURRENCY_ISK,
+	CURRENCY_ITL,
+	CURRENCY_JEP,
+	CURRENCY_JMD,
+	CURRENCY_JOD,
+	CURRENCY_JPY,
+	CURRENCY_KES,
+	CURRENCY_KGS,
+	CURRENCY_KHR,
+	CURRENCY_KMF,
+	CURRENCY_KPW,
+	CURRENCY_KRW,
+	CURRENCY_KWD,
+	CURRENCY_KWd,
+	CURRENCY_KYD,
+	CURRENCY_KZT,
+	CURRENCY_LAK,
+	CURRENCY_LBP,
+	CURRENCY_LKR,
+	CURRENCY_LRD,
+	CURRENCY_LSL,
+	CURRENCY_LTL,
+	CURRENCY_LUF,
+	CURRENCY_LVL,
+	CURRENCY_LYD,
+	CURRENCY_MAD,
+	CURRENCY_MDL,
+	CURRENCY_MGA,
+	CURRENCY_MGF,
+	CURRENCY_MKD,
+	CURRENCY_MLF,
+	CURRENCY_MMK,
+	CURRENCY_MNT,
+	CURRENCY_MOP,
+	CURRENCY_MRO,
+	CURRENCY_MRU,
+	CURRENCY_MTL,
+	CURRENCY_MULTI,
+	CURRENCY_MUR,
+	CURRENCY_MVR,
+	CURRENCY_MWK,
+	CURRENCY_MWk,
+	CURRENCY_MXN,
+	CURRENCY_MYR,
+	CURRENCY_MYr,
+	CURRENCY_MZM,
+	CURRENCY_MZN,
+	CURRENCY_NAD,
+	CURRENCY_NAd,
+	CURRENCY_NGN,
+	CURRENCY_NIC,
+	CURRENCY_NID,
+	CURRENCY_NIO,
+	CURRENCY_NLG,
+	CURRENCY_NOK,
+	CURRENCY_NPR,
+	CURRENCY_NZD,
+	CURRENCY_OMR,
+	CURRENCY_PAB,
+	CURRENCY_PEN,
+	CURRENCY_PGK,
+	CURRENCY_PHP,
+	CURRENCY_PKR,
+	CURRENCY_PLD,
+	CURRENCY_PLN,
+	CURRENCY_PTE,
+	CURRENCY_PYG,
+	CURRENCY_QAR,
+	CURRENCY_ROL,
+	CURRENCY_RON,
+	CURRENCY_RSD,
+	CURRENCY_RUB,
+	CURRENCY_RWF,
+	CURRENCY_SAR,
+	CURRENCY_SBD,
+	CURRENCY_SCR,
+	CURRENCY_SDD,
+	CURRENCY_SDG,
+	CURRENCY_SDP,
+	CURRENCY_SDR,
+	CURRENCY_SEK,
+	CURRENCY_SGD,
+	CURRENCY_SGd,
+	CURRENCY_SHP,
+	CURRENCY_SIT,
+	CURRENCY_SKK,
+	CURRENCY_SLE,
+	CURRENCY_SLL,
+	CURRENCY_SLV,
+	CURRENCY_SOS,
+	CURRENCY_SPL,
+	CURRENCY_SRD,
+	CURRENCY_SRG,
+	CURRENCY_SSP,
+	CURRENCY_STD,
+	CURRENCY_STN,
+	CURRENCY_SVC,
+	CURRENCY_SYP,
+	CURRENCY_SZL,
+	CURRENCY_SZl,
+	CURRENCY_THB,
+	CURRENCY_THO,
+	CURRENCY_TJS,
+	CURRENCY_TMM,
+	CURRENCY_TMT,
+	CURRENCY_TND,
+	CURRENCY_TOP,
+	CURRENCY_TPE,
+	CURRENCY_TRL,
+	CURRENCY_TRY,
+	CURRENCY_TTD,
+	CURRENCY_TVD,
+	CURRENCY_TWD,
+	CURRENCY_TZS,
+	CURRENCY_UAH,
+	CURRENCY_UDI,
+	CURRENCY_UGX,
+	CURRENCY_US,
+	CURRENCY_USD,
+	CURRENCY_USd,
+	CURRENCY_UVR,
+	CURRENCY_UYI,
+	CURRENCY_UYU,
+	CURRENCY_UYW,
+	CURRENCY_UZS,
+	CURRENCY_VEB,
+	CURRENCY_VEE,
+	CURRENCY_VEF,
+	CURRENCY_VES,
+	CURRENCY_VND,
+	CURRENCY_VUV,
+	CURRENCY_WST,
+	CURRENCY_X0S,
+	CURRENCY_X1S,
+	CURRENCY_X2S,
+	CURRENCY_X3S,
+	CURRENCY_X4S,
+	CURRENCY_X5S,
+	CURRENCY_X6S,
+	CURRENCY_X7S,
+	CURRENCY_X8S,
+	CURRENCY_X9S,
+	CURRENCY_XAD,
+	CURRENCY_XAF,
+	CURRENCY_XAG,
+	CURRENCY_XAL,
+	CURRENCY_XAO,
+	CURRENCY_XAS,
+	CURRENCY_XAU,
+	CURRENCY_XAV,
+	CURRENCY_XBA,
+	CURRENCY_XBI,
+	CURRENCY_XBN,
+	CURRENCY_XBS,
+	CURRENCY_XBT,
+	CURRENCY_XBW,
+	CURRENCY_XCD,
+	CURRENCY_XCR,
+	CURRENCY_XCS,
+	CURRENCY_XCU,
+	CURRENCY_XDG,
+	CURRENCY_XDH,
+	CURRENCY_XDI,
+	CURRENCY_XDO,
+	CURRENCY_XDR,
+	CURRENCY_XDT,
+	CURRENCY_XEG,
+	CURRENCY_XEN,
+	CURRENCY_XEO,
+	CURRENCY_XET,
+	CURRENCY_XEU,
+	CURRENCY_XFI,
+	CURRENCY_XFL,
+	CURRENCY_XFM,
+	CURRENCY_XFT,
+	CURRENCY_XGZ,
+	CURRENCY_XHB,
+	CURRENCY_XIC,
+	CURRENCY_XIN,
+	CURRENCY_XIO,
+	CURRENCY_XLC,
+	CURRENCY_XLI,
+	CURRENCY_XLM,
+	CURRENCY_XLU,
+	CURRENCY_XMA,
+	CURRENCY_XMK,
+	CURRENCY_XMN,
+	CURRENCY_XMR,
+	CURRENCY_XNI,
+	CURRENCY_XOF,
+	CURRENCY_XPB,
+	CURRENCY_XPD,
+	CURRENCY_XPF,
+	CURRENCY_XPT,
+	CURRENCY_XRA,
+	CURRENCY_XRH,
+	CURRENCY_XRI,
+	CURRENCY_XRP,
+	CURRENCY_XRU,
+	CURRENCY_XSA,
+	CURRENCY_XSN,
+	CURRENCY_XSO,
+	CURRENCY_XST,
+	CURRENCY_XSU,
+	CURRENCY_XTH,
+	CURRENCY_XTK,
+	CURRENCY_XTR,
+	CURRENCY_XUC,
+	CURRENCY_XUN,
+	CURRENCY_XUT,
+	CURRENCY_XVC,
+	CURRENCY_XVV,
+	CURRENCY_XXT,
+	CURRENCY_XZC,
+	CURRENCY_XZI,
+	CURRENCY_YER,
+	CURRENCY_ZAR,
+	CURRENCY_ZAr,
+	CURRENCY_ZMK,
+	CURRENCY_ZMW,
+	CURRENCY_ZWD,
+	CURRENCY_ZWF,
+	CURRENCY_ZWG,
+	CURRENCY_ZWL,
+	CURRENCY_ZWN,
+	CURRENCY_ZWR,
+	CURRENCY_ZWd,
+	CURRENCY_ZWg,
+}
+
+// Number of generated currency values
+func CurrencyCount() int {
+	return len(currencyValues)
+}
+
+// Whether no currency value was generated, e.g. after a broken run
+func CurrencyIsEmpty() bool {
+	return len(currencyValues) == 0
+}
+
+// Generated currency values, sorted
+func Currencies() []string {
+	return slices.Clone(currencyValues)
+}
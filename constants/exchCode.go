@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of exchCode, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type ExchCode string
+
 const (
 	EXCHCODE_A0               = "A0"
 	EXCHCODE_AA               = "AA"
@@ -33,7 +39,6 @@ const (
 	EXCHCODE_AP               = "AP"
 	EXCHCODE_APX              = "APX"
 	EXCHCODE_AQ               = "AQ"
-	EXCHCODE_Aquis            = "Aquis"
 	EXCHCODE_AR               = "AR"
 	EXCHCODE_ARMENIA          = "ARMENIA"
 	EXCHCODE_AS               = "AS"
@@ -50,6 +55,7 @@ const (
 	EXCHCODE_AX               = "AX"
 	EXCHCODE_AY               = "AY"
 	EXCHCODE_AZ               = "AZ"
+	EXCHCODE_Aquis            = "Aquis"
 	EXCHCODE_B1               = "B1"
 	EXCHCODE_B2               = "B2"
 	EXCHCODE_B3               = "B3"
@@ -65,8 +71,6 @@ const (
 	EXCHCODE_BATS             = "BATS"
 	EXCHCODE_BB               = "BB"
 	EXCHCODE_BBOX             = "BBOX"
-	EXCHCODE_bbox             = "bbox"
-	EXCHCODE_bbsp             = "bbsp"
 	EXCHCODE_BBX              = "BBX"
 	EXCHCODE_BC               = "BC"
 	EXCHCODE_BCEX             = "BCEX"
@@ -78,58 +82,44 @@ const (
 	EXCHCODE_BELARUS          = "BELARUS"
 	EXCHCODE_BELGRADE         = "BELGRADE"
 	EXCHCODE_BEQU             = "BEQU"
-	EXCHCODE_bequ             = "bequ"
 	EXCHCODE_BERLIN           = "BERLIN"
 	EXCHCODE_BERMUDA          = "BERMUDA"
 	EXCHCODE_BERN             = "BERN"
 	EXCHCODE_BEVSA            = "BEVSA"
 	EXCHCODE_BF               = "BF"
 	EXCHCODE_BFLY             = "BFLY"
-	EXCHCODE_bfly             = "bfly"
 	EXCHCODE_BFNX             = "BFNX"
-	EXCHCODE_bfnx             = "bfnx"
 	EXCHCODE_BFO              = "BFO"
 	EXCHCODE_BFRX             = "BFRX"
-	EXCHCODE_bfrx             = "bfrx"
 	EXCHCODE_BFX              = "BFX"
 	EXCHCODE_BG               = "BG"
 	EXCHCODE_BGC              = "BGC"
 	EXCHCODE_BGON             = "BGON"
-	EXCHCODE_bgon             = "bgon"
 	EXCHCODE_BH               = "BH"
 	EXCHCODE_BI               = "BI"
 	EXCHCODE_BIDS             = "BIDS"
 	EXCHCODE_BILBAO           = "BILBAO"
 	EXCHCODE_BINC             = "BINC"
-	EXCHCODE_binc             = "binc"
 	EXCHCODE_BITZ             = "BITZ"
 	EXCHCODE_BIVA             = "BIVA"
 	EXCHCODE_BJEX             = "BJEX"
 	EXCHCODE_BK               = "BK"
 	EXCHCODE_BL3P             = "BL3P"
-	EXCHCODE_blc2             = "blc2"
 	EXCHCODE_BLCR             = "BLCR"
-	EXCHCODE_blcr             = "blcr"
 	EXCHCODE_BM               = "BM"
 	EXCHCODE_BMF              = "BMF"
 	EXCHCODE_BN               = "BN"
 	EXCHCODE_BNCE             = "BNCE"
-	EXCHCODE_bnce             = "bnce"
 	EXCHCODE_BNDX             = "BNDX"
 	EXCHCODE_BNF              = "BNF"
 	EXCHCODE_BNUS             = "BNUS"
-	EXCHCODE_bnus             = "bnus"
 	EXCHCODE_BO               = "BO"
-	EXCHCODE_Bodiva           = "Bodiva"
 	EXCHCODE_BOLSACENTROAMER  = "BOLSA CENTROAMER"
 	EXCHCODE_BOLSANACLVALOR   = "BOLSA NACL VALOR"
-	EXCHCODE_Bondvision       = "Bondvision"
 	EXCHCODE_BORSAISTANBUL    = "BORSA ISTANBUL"
 	EXCHCODE_BOTSWANA         = "BOTSWANA"
 	EXCHCODE_BOV              = "BOV"
 	EXCHCODE_BP               = "BP"
-	EXCHCODE_Bpm              = "Bpm"
-	EXCHCODE_bpnd             = "bpnd"
 	EXCHCODE_BPVB             = "BPVB"
 	EXCHCODE_BQ               = "BQ"
 	EXCHCODE_BR               = "BR"
@@ -139,19 +129,12 @@ const (
 	EXCHCODE_BSE              = "BSE"
 	EXCHCODE_BT               = "BT"
 	EXCHCODE_BTBA             = "BTBA"
-	EXCHCODE_btba             = "btba"
 	EXCHCODE_BTBY             = "BTBY"
 	EXCHCODE_BTCA             = "BTCA"
-	EXCHCODE_btcb             = "btcb"
-	EXCHCODE_bthb             = "bthb"
-	EXCHCODE_btmx             = "btmx"
 	EXCHCODE_BTRK             = "BTRK"
-	EXCHCODE_btrk             = "btrk"
 	EXCHCODE_BTRX             = "BTRX"
-	EXCHCODE_btrx             = "btrx"
 	EXCHCODE_BTS              = "BTS"
 	EXCHCODE_BTSO             = "BTSO"
-	EXCHCODE_btso             = "btso"
 	EXCHCODE_BU               = "BU"
 	EXCHCODE_BUCHAREST        = "BUCHAREST"
 	EXCHCODE_BUDAPEST         = "BUDAPEST"
@@ -166,6 +149,9 @@ const (
 	EXCHCODE_BXSWISS          = "BX - SWISS"
 	EXCHCODE_BY               = "BY"
 	EXCHCODE_BZ               = "BZ"
+	EXCHCODE_Bodiva           = "Bodiva"
+	EXCHCODE_Bondvision       = "Bondvision"
+	EXCHCODE_Bpm              = "Bpm"
 	EXCHCODE_C1               = "C1"
 	EXCHCODE_C2               = "C2"
 	EXCHCODE_C3               = "C3"
@@ -179,10 +165,8 @@ const (
 	EXCHCODE_CBO              = "CBO"
 	EXCHCODE_CBOE             = "CBOE"
 	EXCHCODE_CBSE             = "CBSE"
-	EXCHCODE_cbse             = "cbse"
 	EXCHCODE_CBT              = "CBT"
 	EXCHCODE_CC               = "CC"
-	EXCHCODE_ccck             = "ccck"
 	EXCHCODE_CCO              = "CCO"
 	EXCHCODE_CCT              = "CCT"
 	EXCHCODE_CCX              = "CCX"
@@ -192,7 +176,6 @@ const (
 	EXCHCODE_CEG              = "CEG"
 	EXCHCODE_CENTANOTACIONE   = "CENT ANOTACIONE"
 	EXCHCODE_CEXI             = "CEXI"
-	EXCHCODE_cexi             = "cexi"
 	EXCHCODE_CF               = "CF"
 	EXCHCODE_CFF              = "CFF"
 	EXCHCODE_CFLR             = "CFLR"
@@ -200,7 +183,6 @@ const (
 	EXCHCODE_CH               = "CH"
 	EXCHCODE_CHANNELISLANDS   = "CHANNEL ISLANDS"
 	EXCHCODE_CHIX             = "CHI-X"
-	EXCHCODE_ChiXAustralia    = "Chi-X Australia"
 	EXCHCODE_CHICAGO          = "CHICAGO"
 	EXCHCODE_CHINAINTERBANK   = "CHINA INTERBANK"
 	EXCHCODE_CHONGWAASSETEX   = "CHONGWA ASSET EX"
@@ -214,35 +196,30 @@ const (
 	EXCHCODE_CMX              = "CMX"
 	EXCHCODE_CN               = "CN"
 	EXCHCODE_CNEX             = "CNEX"
-	EXCHCODE_cnex             = "cnex"
 	EXCHCODE_CNGG             = "CNGG"
 	EXCHCODE_CNMT             = "CNMT"
 	EXCHCODE_CNSX             = "CNSX"
 	EXCHCODE_CO               = "CO"
 	EXCHCODE_COLOMBIA         = "COLOMBIA"
 	EXCHCODE_COLOMBO          = "COLOMBO"
-	EXCHCODE_cone             = "cone"
 	EXCHCODE_COP              = "COP"
 	EXCHCODE_CP               = "CP"
 	EXCHCODE_CQ               = "CQ"
 	EXCHCODE_CR               = "CR"
 	EXCHCODE_CRCO             = "CRCO"
-	EXCHCODE_crco             = "crco"
-	EXCHCODE_crv2             = "crv2"
 	EXCHCODE_CS               = "CS"
 	EXCHCODE_CSE              = "CSE"
 	EXCHCODE_CT               = "CT"
 	EXCHCODE_CU               = "CU"
 	EXCHCODE_CUCY             = "CUCY"
-	EXCHCODE_cucy             = "cucy"
 	EXCHCODE_CURV             = "CURV"
-	EXCHCODE_curv             = "curv"
 	EXCHCODE_CV               = "CV"
 	EXCHCODE_CW               = "CW"
 	EXCHCODE_CX               = "CX"
 	EXCHCODE_CY               = "CY"
 	EXCHCODE_CYPRUS           = "CYPRUS"
 	EXCHCODE_CZ               = "CZ"
+	EXCHCODE_ChiXAustralia    = "Chi-X Australia"
 	EXCHCODE_DARESSALAAM      = "DAR-ES-SALAAM"
 	EXCHCODE_DB               = "DB"
 	EXCHCODE_DBSDigital       = "DBS Digital"
@@ -251,7 +228,6 @@ const (
 	EXCHCODE_DD               = "DD"
 	EXCHCODE_DE               = "DE"
 	EXCHCODE_DEB              = "DEB"
-	EXCHCODE_delt             = "delt"
 	EXCHCODE_DF               = "DF"
 	EXCHCODE_DFX              = "DFX"
 	EXCHCODE_DG               = "DG"
@@ -265,7 +241,6 @@ const (
 	EXCHCODE_DME              = "DME"
 	EXCHCODE_DN               = "DN"
 	EXCHCODE_DOUALA           = "DOUALA"
-	EXCHCODE_drbt             = "drbt"
 	EXCHCODE_DS               = "DS"
 	EXCHCODE_DT               = "DT"
 	EXCHCODE_DU               = "DU"
@@ -302,7 +277,6 @@ const (
 	EXCHCODE_EQ               = "EQ"
 	EXCHCODE_ERI              = "ERI"
 	EXCHCODE_ERIS             = "ERIS"
-	EXCHCODE_eris             = "eris"
 	EXCHCODE_ES               = "ES"
 	EXCHCODE_ESWATINI         = "ESWATINI"
 	EXCHCODE_ET               = "ET"
@@ -321,11 +295,11 @@ const (
 	EXCHCODE_EUWAXSTUTTGART   = "EUWAX STUTTGART"
 	EXCHCODE_EUX              = "EUX"
 	EXCHCODE_EX               = "EX"
-	EXCHCODE_ExtraMOT         = "Extra MOT"
-	EXCHCODE_ExtraMOTPro      = "Extra MOT Pro"
 	EXCHCODE_EXXA             = "EXXA"
 	EXCHCODE_EY               = "EY"
 	EXCHCODE_EZ               = "EZ"
+	EXCHCODE_ExtraMOT         = "Extra MOT"
+	EXCHCODE_ExtraMOTPro      = "Extra MOT Pro"
 	EXCHCODE_FA               = "FA"
 	EXCHCODE_FEX              = "FEX"
 	EXCHCODE_FF               = "FF"
@@ -352,19 +326,16 @@ const (
 	EXCHCODE_GE               = "GE"
 	EXCHCODE_GEMMA            = "GEMMA"
 	EXCHCODE_GEORGIA          = "GEORGIA"
-	EXCHCODE_Gettex           = "Gettex"
 	EXCHCODE_GF               = "GF"
 	EXCHCODE_GG               = "GG"
 	EXCHCODE_GH               = "GH"
 	EXCHCODE_GHANA            = "GHANA"
 	EXCHCODE_GI               = "GI"
-	EXCHCODE_Gibraltar        = "Gibraltar"
 	EXCHCODE_GK               = "GK"
 	EXCHCODE_GL               = "GL"
 	EXCHCODE_GM               = "GM"
 	EXCHCODE_GME              = "GME"
 	EXCHCODE_GMNI             = "GMNI"
-	EXCHCODE_gmni             = "gmni"
 	EXCHCODE_GN               = "GN"
 	EXCHCODE_GQ               = "GQ"
 	EXCHCODE_GR               = "GR"
@@ -376,6 +347,8 @@ const (
 	EXCHCODE_GW               = "GW"
 	EXCHCODE_GY               = "GY"
 	EXCHCODE_GZ               = "GZ"
+	EXCHCODE_Gettex           = "Gettex"
+	EXCHCODE_Gibraltar        = "Gibraltar"
 	EXCHCODE_H1               = "H1"
 	EXCHCODE_H2               = "H2"
 	EXCHCODE_HAMBURG          = "HAMBURG"
@@ -388,7 +361,6 @@ const (
 	EXCHCODE_HEX              = "HEX"
 	EXCHCODE_HIMTF            = "HI-MTF"
 	EXCHCODE_HITB             = "HITB"
-	EXCHCODE_hitb             = "hitb"
 	EXCHCODE_HK               = "HK"
 	EXCHCODE_HKG              = "HKG"
 	EXCHCODE_HKM              = "HKM"
@@ -397,7 +369,6 @@ const (
 	EXCHCODE_HO               = "HO"
 	EXCHCODE_HONGKONG         = "HONG KONG"
 	EXCHCODE_HUOB             = "HUOB"
-	EXCHCODE_huob             = "huob"
 	EXCHCODE_HX               = "HX"
 	EXCHCODE_I2               = "I2"
 	EXCHCODE_IA               = "IA"
@@ -424,7 +395,6 @@ const (
 	EXCHCODE_INCH             = "INCH"
 	EXCHCODE_INDIAINX         = "INDIA INX"
 	EXCHCODE_INDONESIAEXCH    = "INDONESIA EXCH"
-	EXCHCODE_indr             = "indr"
 	EXCHCODE_INE              = "INE"
 	EXCHCODE_INTERCONTINENTAL = "INTERCONTINENTAL"
 	EXCHCODE_INX              = "INX"
@@ -439,7 +409,6 @@ const (
 	EXCHCODE_IST              = "IST"
 	EXCHCODE_IT               = "IT"
 	EXCHCODE_ITBI             = "ITBI"
-	EXCHCODE_itbi             = "itbi"
 	EXCHCODE_IX               = "IX"
 	EXCHCODE_IY               = "IY"
 	EXCHCODE_JA               = "JA"
@@ -476,7 +445,6 @@ const (
 	EXCHCODE_KB               = "KB"
 	EXCHCODE_KCB              = "KCB"
 	EXCHCODE_KCON             = "KCON"
-	EXCHCODE_kcon             = "kcon"
 	EXCHCODE_KE               = "KE"
 	EXCHCODE_KF               = "KF"
 	EXCHCODE_KFE              = "KFE"
@@ -485,13 +453,11 @@ const (
 	EXCHCODE_KK               = "KK"
 	EXCHCODE_KL               = "KL"
 	EXCHCODE_KN               = "KN"
-	EXCHCODE_korb             = "korb"
 	EXCHCODE_KOREA            = "KOREA"
 	EXCHCODE_KOSDAQ           = "KOSDAQ"
 	EXCHCODE_KP               = "KP"
 	EXCHCODE_KQ               = "KQ"
 	EXCHCODE_KRKN             = "KRKN"
-	EXCHCODE_krkn             = "krkn"
 	EXCHCODE_KS               = "KS"
 	EXCHCODE_KUWAIT           = "KUWAIT"
 	EXCHCODE_KX               = "KX"
@@ -516,7 +482,6 @@ const (
 	EXCHCODE_LISBON           = "LISBON"
 	EXCHCODE_LJUBLJANA        = "LJUBLJANA"
 	EXCHCODE_LMAX             = "LMAX"
-	EXCHCODE_lmax             = "lmax"
 	EXCHCODE_LME              = "LME"
 	EXCHCODE_LMP              = "LMP"
 	EXCHCODE_LN               = "LN"
@@ -563,7 +528,6 @@ const (
 	EXCHCODE_MERJ             = "MERJ"
 	EXCHCODE_MERVAL           = "MERVAL"
 	EXCHCODE_MET              = "MET"
-	EXCHCODE_mexc             = "mexc"
 	EXCHCODE_MEXICO           = "MEXICO"
 	EXCHCODE_MF               = "MF"
 	EXCHCODE_MFA              = "MFA"
@@ -602,10 +566,10 @@ const (
 	EXCHCODE_MTSAMSTERDAM     = "MTS AMSTERDAM"
 	EXCHCODE_MTSAustria       = "MTS Austria"
 	EXCHCODE_MTSBELGIUM       = "MTS BELGIUM"
-	EXCHCODE_MTSFinland       = "MTS Finland"
 	EXCHCODE_MTSFRANCE        = "MTS FRANCE"
-	EXCHCODE_MTSGermany       = "MTS Germany"
+	EXCHCODE_MTSFinland       = "MTS Finland"
 	EXCHCODE_MTSGREECE        = "MTS GREECE"
+	EXCHCODE_MTSGermany       = "MTS Germany"
 	EXCHCODE_MTSIRELAND       = "MTS IRELAND"
 	EXCHCODE_MTSIsrael        = "MTS Israel"
 	EXCHCODE_MTSPORTUGAL      = "MTS PORTUGAL"
@@ -680,12 +644,11 @@ const (
 	EXCHCODE_NSEINDIA         = "NSE INDIA"
 	EXCHCODE_NSEL             = "NSEL"
 	EXCHCODE_NSEL1î           = "NSEL 1î"
-	EXCHCODE_NSELh            = "NSEL=h*"
 	EXCHCODE_NSELVÉ           = "NSEL=V:É"
+	EXCHCODE_NSELh            = "NSEL=h*"
 	EXCHCODE_NSELß            = "NSELß↓"
 	EXCHCODE_NT               = "NT"
 	EXCHCODE_NV               = "NV"
-	EXCHCODE_nvdx             = "nvdx"
 	EXCHCODE_NW               = "NW"
 	EXCHCODE_NX               = "NX"
 	EXCHCODE_NY               = "NY"
@@ -703,9 +666,7 @@ const (
 	EXCHCODE_ODE              = "ODE"
 	EXCHCODE_OF               = "OF"
 	EXCHCODE_OKCN             = "OKCN"
-	EXCHCODE_okcn             = "okcn"
 	EXCHCODE_OKEX             = "OKEX"
-	EXCHCODE_okex             = "okex"
 	EXCHCODE_OM               = "OM"
 	EXCHCODE_OMEGACANADAATS   = "OMEGA CANADA ATS"
 	EXCHCODE_OMP              = "OMP"
@@ -714,7 +675,6 @@ const (
 	EXCHCODE_OSAKA2           = "OSAKA 2"
 	EXCHCODE_OSE              = "OSE"
 	EXCHCODE_OSLO             = "OSLO"
-	EXCHCODE_oslx             = "oslx"
 	EXCHCODE_OTCBB            = "OTC BB"
 	EXCHCODE_OTCUS            = "OTC US"
 	EXCHCODE_OU               = "OU"
@@ -737,7 +697,6 @@ const (
 	EXCHCODE_PHL              = "PHL"
 	EXCHCODE_PINKSHEETS       = "PINK SHEETS"
 	EXCHCODE_PK               = "PK"
-	EXCHCODE_pksp             = "pksp"
 	EXCHCODE_PL               = "PL"
 	EXCHCODE_PLX              = "PLX"
 	EXCHCODE_PM               = "PM"
@@ -747,7 +706,6 @@ const (
 	EXCHCODE_PNX              = "PNX"
 	EXCHCODE_PO               = "PO"
 	EXCHCODE_POLO             = "POLO"
-	EXCHCODE_polo             = "polo"
 	EXCHCODE_PORTMORESBY      = "PORT MORESBY"
 	EXCHCODE_PORTAL           = "PORTAL"
 	EXCHCODE_PP               = "PP"
@@ -768,13 +726,12 @@ const (
 	EXCHCODE_QH               = "QH"
 	EXCHCODE_QM               = "QM"
 	EXCHCODE_QN               = "QN"
-	EXCHCODE_qsp3             = "qsp3"
 	EXCHCODE_QT               = "QT"
 	EXCHCODE_QU               = "QU"
 	EXCHCODE_QUITO            = "QUITO"
 	EXCHCODE_QUON             = "QUON"
-	EXCHCODE_Quotrix          = "Quotrix"
 	EXCHCODE_QX               = "QX"
+	EXCHCODE_Quotrix          = "Quotrix"
 	EXCHCODE_RASDAQ           = "RASDAQ"
 	EXCHCODE_RB               = "RB"
 	EXCHCODE_RC               = "RC"
@@ -858,27 +815,23 @@ const (
 	EXCHCODE_SS               = "SS"
 	EXCHCODE_SSE              = "SSE"
 	EXCHCODE_ST               = "ST"
-	EXCHCODE_StPetersburg     = "St. Petersburg"
 	EXCHCODE_STMP             = "STMP"
-	EXCHCODE_stmp             = "stmp"
 	EXCHCODE_STRASBOURG       = "STRASBOURG"
 	EXCHCODE_STUTTGART        = "STUTTGART"
 	EXCHCODE_SU               = "SU"
 	EXCHCODE_SUSH             = "SUSH"
-	EXCHCODE_sush             = "sush"
 	EXCHCODE_SV               = "SV"
 	EXCHCODE_SW               = "SW"
 	EXCHCODE_SX               = "SX"
 	EXCHCODE_SXHA             = "SXHA"
-	EXCHCODE_sxha             = "sxha"
 	EXCHCODE_SY               = "SY"
 	EXCHCODE_SZ               = "SZ"
+	EXCHCODE_StPetersburg     = "St. Petersburg"
 	EXCHCODE_T1               = "T1"
 	EXCHCODE_T2               = "T2"
 	EXCHCODE_T3               = "T3"
 	EXCHCODE_TA               = "TA"
 	EXCHCODE_TAD              = "TAD"
-	EXCHCODE_Taipei           = "Taipei"
 	EXCHCODE_TAIWAN           = "TAIWAN"
 	EXCHCODE_TASHKENT         = "TASHKENT"
 	EXCHCODE_TAV              = "TAV"
@@ -934,6 +887,7 @@ const (
 	EXCHCODE_TX               = "TX"
 	EXCHCODE_TY               = "TY"
 	EXCHCODE_TZ               = "TZ"
+	EXCHCODE_Taipei           = "Taipei"
 	EXCHCODE_UA               = "UA"
 	EXCHCODE_UB               = "UB"
 	EXCHCODE_UC               = "UC"
@@ -955,16 +909,13 @@ const (
 	EXCHCODE_UO               = "UO"
 	EXCHCODE_UP               = "UP"
 	EXCHCODE_UPBT             = "UPBT"
-	EXCHCODE_upbt             = "upbt"
 	EXCHCODE_UQ               = "UQ"
 	EXCHCODE_UR               = "UR"
 	EXCHCODE_URCEX            = "URCEX"
 	EXCHCODE_US               = "US"
 	EXCHCODE_USE              = "USE"
 	EXCHCODE_USP2             = "USP2"
-	EXCHCODE_usp2             = "usp2"
 	EXCHCODE_USP3             = "USP3"
-	EXCHCODE_usp3             = "usp3"
 	EXCHCODE_UT               = "UT"
 	EXCHCODE_UU               = "UU"
 	EXCHCODE_UV               = "UV"
@@ -988,13 +939,13 @@ const (
 	EXCHCODE_VL               = "VL"
 	EXCHCODE_VM               = "VM"
 	EXCHCODE_VN               = "VN"
-	EXCHCODE_Vorvel           = "Vorvel"
 	EXCHCODE_VP               = "VP"
 	EXCHCODE_VR               = "VR"
 	EXCHCODE_VS               = "VS"
 	EXCHCODE_VU               = "VU"
 	EXCHCODE_VX               = "VX"
 	EXCHCODE_VY               = "VY"
+	EXCHCODE_Vorvel           = "Vorvel"
 	EXCHCODE_WARSAW           = "WARSAW"
 	EXCHCODE_WBA              = "WBA"
 	EXCHCODE_WCE              = "WCE"
@@ -1037,15 +988,12 @@ const (
 	EXCHCODE_YELLOWSHEETS     = "YELLOW SHEETS"
 	EXCHCODE_YLX              = "YLX"
 	EXCHCODE_YOBT             = "YOBT"
-	EXCHCODE_yobt             = "yobt"
 	EXCHCODE_YSE              = "YSE"
 	EXCHCODE_ZA               = "ZA"
 	EXCHCODE_ZAGREB           = "ZAGREB"
 	EXCHCODE_ZAIF             = "ZAIF"
-	EXCHCODE_zaif             = "zaif"
 	EXCHCODE_ZB               = "ZB"
 	EXCHCODE_ZBCN             = "ZBCN"
-	EXCHCODE_zbcn             = "zbcn"
 	EXCHCODE_ZC               = "ZC"
 	EXCHCODE_ZCE              = "ZCE"
 	EXCHCODE_ZG               = "ZG"
@@ -1054,4 +1002,1131 @@ const (
 	EXCHCODE_ZL               = "ZL"
 	EXCHCODE_ZS               = "ZS"
 	EXCHCODE_ZU               = "ZU"
+	EXCHCODE_bbox             = "bbox"
+	EXCHCODE_bbsp             = "bbsp"
+	EXCHCODE_bequ             = "bequ"
+	EXCHCODE_bfly             = "bfly"
+	EXCHCODE_bfnx             = "bfnx"
+	EXCHCODE_bfrx             = "bfrx"
+	EXCHCODE_bgon             = "bgon"
+	EXCHCODE_binc             = "binc"
+	EXCHCODE_blc2             = "blc2"
+	EXCHCODE_blcr             = "blcr"
+	EXCHCODE_bnce             = "bnce"
+	EXCHCODE_bnus             = "bnus"
+	EXCHCODE_bpnd             = "bpnd"
+	EXCHCODE_btba             = "btba"
+	EXCHCODE_btcb             = "btcb"
+	EXCHCODE_bthb             = "bthb"
+	EXCHCODE_btmx             = "btmx"
+	EXCHCODE_btrk             = "btrk"
+	EXCHCODE_btrx             = "btrx"
+	EXCHCODE_btso             = "btso"
+	EXCHCODE_cbse             = "cbse"
+	EXCHCODE_ccck             = "ccck"
+	EXCHCODE_cexi             = "cexi"
+	EXCHCODE_cnex             = "cnex"
+	EXCHCODE_cone             = "cone"
+	EXCHCODE_crco             = "crco"
+	EXCHCODE_crv2             = "crv2"
+	EXCHCODE_cucy             = "cucy"
+	EXCHCODE_curv             = "curv"
+	EXCHCODE_delt             = "delt"
+	EXCHCODE_drbt             = "drbt"
+	EXCHCODE_eris             = "eris"
+	EXCHCODE_gmni             = "gmni"
+	EXCHCODE_hitb             = "hitb"
+	EXCHCODE_huob             = "huob"
+	EXCHCODE_indr             = "indr"
+	EXCHCODE_itbi             = "itbi"
+	EXCHCODE_kcon             = "kcon"
+	EXCHCODE_korb             = "korb"
+	EXCHCODE_krkn             = "krkn"
+	EXCHCODE_lmax             = "lmax"
+	EXCHCODE_mexc             = "mexc"
+	EXCHCODE_nvdx             = "nvdx"
+	EXCHCODE_okcn             = "okcn"
+	EXCHCODE_okex             = "okex"
+	EXCHCODE_oslx             = "oslx"
+	EXCHCODE_pksp             = "pksp"
+	EXCHCODE_polo             = "polo"
+	EXCHCODE_qsp3             = "qsp3"
+	EXCHCODE_stmp             = "stmp"
+	EXCHCODE_sush             = "sush"
+	EXCHCODE_sxha             = "sxha"
+	EXCHCODE_upbt             = "upbt"
+	EXCHCODE_usp2             = "usp2"
+	EXCHCODE_usp3             = "usp3"
+	EXCHCODE_yobt             = "yobt"
+	EXCHCODE_zaif             = "zaif"
+	EXCHCODE_zbcn             = "zbcn"
 )
+
+var exchCodeValues = []string{
+	EXCHCODE_A0,
+	EXCHCODE_AA,
+	EXCHCODE_AB,
+	EXCHCODE_ABIDJAN,
+	EXCHCODE_ABUDHABI,
+	EXCHCODE_AC,
+	EXCHCODE_ACE,
+	EXCHCODE_AD,
+	EXCHCODE_ADE,
+	EXCHCODE_ADX,
+	EXCHCODE_AEQUITASNEOLIT,
+	EXCHCODE_AF,
+	EXCHCODE_AFE,
+	EXCHCODE_AG,
+	EXCHCODE_AH,
+	EXCHCODE_AI,
+	EXCHCODE_AIAF,
+	EXCHCODE_AJ,
+	EXCHCODE_AL,
+	EXCHCODE_ALCN,
+	EXCHCODE_ALGIERS,
+	EXCHCODE_ALLGERMANSE,
+	EXCHCODE_AM,
+	EXCHCODE_AME,
+	EXCHCODE_AMMANFINMKT,
+	EXCHCODE_ANTWERP,
+	EXCHCODE_AO,
+	EXCHCODE_AP,
+	EXCHCODE_APX,
+	EXCHCODE_AQ,
+	EXCHCODE_AR,
+	EXCHCODE_ARMENIA,
+	EXCHCODE_AS,
+	EXCHCODE_ASP,
+	EXCHCODE_ASUNCION,
+	EXCHCODE_ASX,
+	EXCHCODE_AT,
+	EXCHCODE_ATA,
+	EXCHCODE_ATHENS,
+	EXCHCODE_AU,
+	EXCHCODE_AUSTRALIA,
+	EXCHCODE_AV,
+	EXCHCODE_AW,
+	EXCHCODE_AX,
+	EXCHCODE_AY,
+	EXCHCODE_AZ,
+	EXCHCODE_Aquis,
+	EXCHCODE_B1,
+	EXCHCODE_B2,
+	EXCHCODE_B3,
+	EXCHCODE_B4,
+	EXCHCODE_BA,
+	EXCHCODE_BAHAMAS,
+	EXCHCODE_BAHRAIN,
+	EXCHCODE_BAKU,
+	EXCHCODE_BANGALORE,
+	EXCHCODE_BANJALUKA,
+	EXCHCODE_BARBADOS,
+	EXCHCODE_BARCELONA,
+	EXCHCODE_BATS,
+	EXCHCODE_BB,
+	EXCHCODE_BBOX,
+	EXCHCODE_BBX,
+	EXCHCODE_BC,
+	EXCHCODE_BCEX,
+	EXCHCODE_BCF,
+	EXCHCODE_BD,
+	EXCHCODE_BDP,
+	EXCHCODE_BEIJING,
+	EXCHCODE_BEIRUT,
+	EXCHCODE_BELARUS,
+	EXCHCODE_BELGRADE,
+	EXCHCODE_BEQU,
+	EXCHCODE_BERLIN,
+	EXCHCODE_BERMUDA,
+	EXCHCODE_BERN,
+	EXCHCODE_BEVSA,
+	EXCHCODE_BF,
+	EXCHCODE_BFLY,
+	EXCHCODE_BFNX,
+	EXCHCODE_BFO,
+	EXCHCODE_BFRX,
+	EXCHCODE_BFX,
+	EXCHCODE_BG,
+	EXCHCODE_BGC,
+	EXCHCODE_BGON,
+	EXCHCODE_BH,
+	EXCHCODE_BI,
+	EXCHCODE_BIDS,
+	EXCHCODE_BILBAO,
+	EXCHCODE_BINC,
+	EXCHCODE_BITZ,
+	EXCHCODE_BIVA,
+	EXCHCODE_BJEX,
+	EXCHCODE_BK,
+	EXCHCODE_BL3P,
+	EXCHCODE_BLCR,
+	EXCHCODE_BM,
+	EXCHCODE_BMF,
+	EXCHCODE_BN,
+	EXCHCODE_BNCE,
+	EXCHCODE_BNDX,
+	EXCHCODE_BNF,
+	EXCHCODE_BNUS,
+	EXCHCODE_BO,
+	EXCHCODE_BOLSACENTROAMER,
+	EXCHCODE_BOLSANACLVALOR,
+	EXCHCODE_BORSAISTANBUL,
+	EXCHCODE_BOTSWANA,
+	EXCHCODE_BOV,
+	EXCHCODE_BP,
+	EXCHCODE_BPVB,
+	EXCHCODE_BQ,
+	EXCHCODE_BR,
+	EXCHCODE_BRATISLAVA,
+	EXCHCODE_BRJ,
+	EXCHCODE_BS,
+	EXCHCODE_BSE,
+	EXCHCODE_BT,
+	EXCHCODE_BTBA,
+	EXCHCODE_BTBY,
+	EXCHCODE_BTCA,
+	EXCHCODE_BTRK,
+	EXCHCODE_BTRX,
+	EXCHCODE_BTS,
+	EXCHCODE_BTSO,
+	EXCHCODE_BU,
+	EXCHCODE_BUCHAREST,
+	EXCHCODE_BUDAPEST,
+	EXCHCODE_BUENOSAIRES,
+	EXCHCODE_BULGARIA,
+	EXCHCODE_BURGUNDY,
+	EXCHCODE_BURSAMALAYSIA,
+	EXCHCODE_BV,
+	EXCHCODE_BVL,
+	EXCHCODE_BW,
+	EXCHCODE_BX,
+	EXCHCODE_BXSWISS,
+	EXCHCODE_BY,
+	EXCHCODE_BZ,
+	EXCHCODE_Bodiva,
+	EXCHCODE_Bondvision,
+	EXCHCODE_Bpm,
+	EXCHCODE_C1,
+	EXCHCODE_C2,
+	EXCHCODE_C3,
+	EXCHCODE_CA,
+	EXCHCODE_CARACAS,
+	EXCHCODE_CASABLANCA,
+	EXCHCODE_CAYMANISLANDS,
+	EXCHCODE_CB,
+	EXCHCODE_CBD,
+	EXCHCODE_CBF,
+	EXCHCODE_CBO,
+	EXCHCODE_CBOE,
+	EXCHCODE_CBSE,
+	EXCHCODE_CBT,
+	EXCHCODE_CC,
+	EXCHCODE_CCO,
+	EXCHCODE_CCT,
+	EXCHCODE_CCX,
+	EXCHCODE_CD,
+	EXCHCODE_CDE,
+	EXCHCODE_CE,
+	EXCHCODE_CEG,
+	EXCHCODE_CENTANOTACIONE,
+	EXCHCODE_CEXI,
+	EXCHCODE_CF,
+	EXCHCODE_CFF,
+	EXCHCODE_CFLR,
+	EXCHCODE_CG,
+	EXCHCODE_CH,
+	EXCHCODE_CHANNELISLANDS,
+	EXCHCODE_CHIX,
+	EXCHCODE_CHICAGO,
+	EXCHCODE_CHINAINTERBANK,
+	EXCHCODE_CHONGWAASSETEX,
+	EXCHCODE_CI,
+	EXCHCODE_CJ,
+	EXCHCODE_CK,
+	EXCHCODE_CL,
+	EXCHCODE_CM,
+	EXCHCODE_CME,
+	EXCHCODE_CMF,
+	EXCHCODE_CMX,
+	EXCHCODE_CN,
+	EXCHCODE_CNEX,
+	EXCHCODE_CNGG,
+	EXCHCODE_CNMT,
+	EXCHCODE_CNSX,
+	EXCHCODE_CO,
+	EXCHCODE_COLOMBIA,
+	EXCHCODE_COLOMBO,
+	EXCHCODE_COP,
+	EXCHCODE_CP,
+	EXCHCODE_CQ,
+	EXCHCODE_CR,
+	EXCHCODE_CRCO,
+	EXCHCODE_CS,
+	EXCHCODE_CSE,
+	EXCHCODE_CT,
+	EXCHCODE_CU,
+	EXCHCODE_CUCY,
+	EXCHCODE_CURV,
+	EXCHCODE_CV,
+	EXCHCODE_CW,
+	EXCHCODE_CX,
+	EXCHCODE_CY,
+	EXCHCODE_CYPRUS,
+	EXCHCODE_CZ,
+	EXCHCODE_ChiXAustralia,
+	EXCHCODE_DARESSALAAM,
+	EXCHCODE_DB,
+	EXCHCODE_DBSDigital,
+	EXCHCODE_DC,
+	EXCHCODE_DCE,
+	EXCHCODE_DD,
+	EXCHCODE_DE,
+	EXCHCODE_DEB,
+	EXCHCODE_DF,
+	EXCHCODE_DFX,
+	EXCHCODE_DG,
+	EXCHCODE_DGC,
+	EXCHCODE_DH,
+	EXCHCODE_DHAKA,
+	EXCHCODE_DJ,
+	EXCHCODE_DK,
+	EXCHCODE_DL,
+	EXCHCODE_DM,
+	EXCHCODE_DME,
+	EXCHCODE_DN,
+	EXCHCODE_DOUALA,
+	EXCHCODE_DS,
+	EXCHCODE_DT,
+	EXCHCODE_DU,
+	EXCHCODE_DUBAIFINLMKT,
+	EXCHCODE_DUBLIN,
+	EXCHCODE_DUSSELDORF,
+	EXCHCODE_DV,
+	EXCHCODE_DVX,
+	EXCHCODE_DX,
+	EXCHCODE_E1,
+	EXCHCODE_E2,
+	EXCHCODE_EA,
+	EXCHCODE_EASTCARIBBEAN,
+	EXCHCODE_EB,
+	EXCHCODE_EC,
+	EXCHCODE_ED,
+	EXCHCODE_EDX,
+	EXCHCODE_EEE,
+	EXCHCODE_EG,
+	EXCHCODE_EGX,
+	EXCHCODE_EI,
+	EXCHCODE_EK,
+	EXCHCODE_EL,
+	EXCHCODE_ELSALVADOR,
+	EXCHCODE_ELECTRONICCHILE,
+	EXCHCODE_ELX,
+	EXCHCODE_EM,
+	EXCHCODE_EN,
+	EXCHCODE_EO,
+	EXCHCODE_EOC,
+	EXCHCODE_EOE,
+	EXCHCODE_EOP,
+	EXCHCODE_EP,
+	EXCHCODE_EQ,
+	EXCHCODE_ERI,
+	EXCHCODE_ERIS,
+	EXCHCODE_ES,
+	EXCHCODE_ESWATINI,
+	EXCHCODE_ET,
+	EXCHCODE_EU,
+	EXCHCODE_EUROMTF,
+	EXCHCODE_EUROMTS,
+	EXCHCODE_EURONEXTAMSTER,
+	EXCHCODE_EURONEXTBRUSS,
+	EXCHCODE_EURONEXTDUBLIN,
+	EXCHCODE_EURONEXTGRWMIL,
+	EXCHCODE_EURONEXTLISBON,
+	EXCHCODE_EURONEXTMILAN,
+	EXCHCODE_EURONEXTPARIS,
+	EXCHCODE_EUROTLX,
+	EXCHCODE_EUS,
+	EXCHCODE_EUWAXSTUTTGART,
+	EXCHCODE_EUX,
+	EXCHCODE_EX,
+	EXCHCODE_EXXA,
+	EXCHCODE_EY,
+	EXCHCODE_EZ,
+	EXCHCODE_ExtraMOT,
+	EXCHCODE_ExtraMOTPro,
+	EXCHCODE_FA,
+	EXCHCODE_FEX,
+	EXCHCODE_FF,
+	EXCHCODE_FFZERTIFIKATE,
+	EXCHCODE_FFE,
+	EXCHCODE_FH,
+	EXCHCODE_FMX,
+	EXCHCODE_FNX,
+	EXCHCODE_FP,
+	EXCHCODE_FPL,
+	EXCHCODE_FRANKFURT,
+	EXCHCODE_FRX,
+	EXCHCODE_FS,
+	EXCHCODE_FTX,
+	EXCHCODE_FTXX,
+	EXCHCODE_FUKUOKA,
+	EXCHCODE_G1,
+	EXCHCODE_G4,
+	EXCHCODE_GA,
+	EXCHCODE_GB,
+	EXCHCODE_GBT,
+	EXCHCODE_GC,
+	EXCHCODE_GD,
+	EXCHCODE_GE,
+	EXCHCODE_GEMMA,
+	EXCHCODE_GEORGIA,
+	EXCHCODE_GF,
+	EXCHCODE_GG,
+	EXCHCODE_GH,
+	EXCHCODE_GHANA,
+	EXCHCODE_GI,
+	EXCHCODE_GK,
+	EXCHCODE_GL,
+	EXCHCODE_GM,
+	EXCHCODE_GME,
+	EXCHCODE_GMNI,
+	EXCHCODE_GN,
+	EXCHCODE_GQ,
+	EXCHCODE_GR,
+	EXCHCODE_GS,
+	EXCHCODE_GT,
+	EXCHCODE_GU,
+	EXCHCODE_GUATEMALA,
+	EXCHCODE_GUAYAQUIL,
+	EXCHCODE_GW,
+	EXCHCODE_GY,
+	EXCHCODE_GZ,
+	EXCHCODE_Gettex,
+	EXCHCODE_Gibraltar,
+	EXCHCODE_H1,
+	EXCHCODE_H2,
+	EXCHCODE_HAMBURG,
+	EXCHCODE_HANNOVER,
+	EXCHCODE_HANOI,
+	EXCHCODE_HB,
+	EXCHCODE_HCMCITYEXCH,
+	EXCHCODE_HD,
+	EXCHCODE_HE,
+	EXCHCODE_HEX,
+	EXCHCODE_HIMTF,
+	EXCHCODE_HITB,
+	EXCHCODE_HK,
+	EXCHCODE_HKG,
+	EXCHCODE_HKM,
+	EXCHCODE_HM,
+	EXCHCODE_HNX,
+	EXCHCODE_HO,
+	EXCHCODE_HONGKONG,
+	EXCHCODE_HUOB,
+	EXCHCODE_HX,
+	EXCHCODE_I2,
+	EXCHCODE_IA,
+	EXCHCODE_IAD,
+	EXCHCODE_IB,
+	EXCHCODE_IC,
+	EXCHCODE_ICD,
+	EXCHCODE_ICE,
+	EXCHCODE_ICEECX,
+	EXCHCODE_ICF,
+	EXCHCODE_ID,
+	EXCHCODE_IDEM,
+	EXCHCODE_IDR,
+	EXCHCODE_IDX,
+	EXCHCODE_IE,
+	EXCHCODE_IEA,
+	EXCHCODE_IF,
+	EXCHCODE_IFE,
+	EXCHCODE_IG,
+	EXCHCODE_IH,
+	EXCHCODE_IJ,
+	EXCHCODE_IM,
+	EXCHCODE_IN,
+	EXCHCODE_INCH,
+	EXCHCODE_INDIAINX,
+	EXCHCODE_INDONESIAEXCH,
+	EXCHCODE_INE,
+	EXCHCODE_INTERCONTINENTAL,
+	EXCHCODE_INX,
+	EXCHCODE_IO,
+	EXCHCODE_IQ,
+	EXCHCODE_IR,
+	EXCHCODE_IS,
+	EXCHCODE_ISE,
+	EXCHCODE_ISF,
+	EXCHCODE_ISG,
+	EXCHCODE_ISLANDECNLTD,
+	EXCHCODE_IST,
+	EXCHCODE_IT,
+	EXCHCODE_ITBI,
+	EXCHCODE_IX,
+	EXCHCODE_IY,
+	EXCHCODE_JA,
+	EXCHCODE_JAMAICA,
+	EXCHCODE_JASDAQ,
+	EXCHCODE_JB,
+	EXCHCODE_JC,
+	EXCHCODE_JD,
+	EXCHCODE_JE,
+	EXCHCODE_JF,
+	EXCHCODE_JFX,
+	EXCHCODE_JG,
+	EXCHCODE_JI,
+	EXCHCODE_JJ,
+	EXCHCODE_JM,
+	EXCHCODE_JN,
+	EXCHCODE_JO,
+	EXCHCODE_JOHANNESBURG,
+	EXCHCODE_JP,
+	EXCHCODE_JQ,
+	EXCHCODE_JR,
+	EXCHCODE_JS,
+	EXCHCODE_JSECentOrdBk,
+	EXCHCODE_JSEContribPrx,
+	EXCHCODE_JT,
+	EXCHCODE_JU,
+	EXCHCODE_JV,
+	EXCHCODE_JW,
+	EXCHCODE_JX,
+	EXCHCODE_JY,
+	EXCHCODE_KA,
+	EXCHCODE_KAS,
+	EXCHCODE_KAZAKHSTAN,
+	EXCHCODE_KB,
+	EXCHCODE_KCB,
+	EXCHCODE_KCON,
+	EXCHCODE_KE,
+	EXCHCODE_KF,
+	EXCHCODE_KFE,
+	EXCHCODE_KH,
+	EXCHCODE_KIEV,
+	EXCHCODE_KK,
+	EXCHCODE_KL,
+	EXCHCODE_KN,
+	EXCHCODE_KOREA,
+	EXCHCODE_KOSDAQ,
+	EXCHCODE_KP,
+	EXCHCODE_KQ,
+	EXCHCODE_KRKN,
+	EXCHCODE_KS,
+	EXCHCODE_KUWAIT,
+	EXCHCODE_KX,
+	EXCHCODE_KY,
+	EXCHCODE_KYRGZSTAN,
+	EXCHCODE_KZ,
+	EXCHCODE_L1,
+	EXCHCODE_L3,
+	EXCHCODE_LA,
+	EXCHCODE_LAPAZ,
+	EXCHCODE_LABUANINTLFIN,
+	EXCHCODE_LB,
+	EXCHCODE_LC,
+	EXCHCODE_LCLB,
+	EXCHCODE_LD,
+	EXCHCODE_LDX,
+	EXCHCODE_LE,
+	EXCHCODE_LF,
+	EXCHCODE_LG,
+	EXCHCODE_LH,
+	EXCHCODE_LI,
+	EXCHCODE_LISBON,
+	EXCHCODE_LJUBLJANA,
+	EXCHCODE_LMAX,
+	EXCHCODE_LME,
+	EXCHCODE_LMP,
+	EXCHCODE_LN,
+	EXCHCODE_LO,
+	EXCHCODE_LONDON,
+	EXCHCODE_LONDONINTL,
+	EXCHCODE_LR,
+	EXCHCODE_LS,
+	EXCHCODE_LSE,
+	EXCHCODE_LSERETAIL,
+	EXCHCODE_LT,
+	EXCHCODE_LU,
+	EXCHCODE_LUSAKA,
+	EXCHCODE_LUXEMBOURG,
+	EXCHCODE_LV,
+	EXCHCODE_LX,
+	EXCHCODE_LY,
+	EXCHCODE_LYON,
+	EXCHCODE_M0,
+	EXCHCODE_MA,
+	EXCHCODE_MACEDONIA,
+	EXCHCODE_MADRAS,
+	EXCHCODE_MADRID,
+	EXCHCODE_MAE,
+	EXCHCODE_MALAWI,
+	EXCHCODE_MALTA,
+	EXCHCODE_MANAGUA,
+	EXCHCODE_MARF,
+	EXCHCODE_MARSEILLE,
+	EXCHCODE_MAURITIUS,
+	EXCHCODE_MB,
+	EXCHCODE_MBA,
+	EXCHCODE_MC,
+	EXCHCODE_MCE,
+	EXCHCODE_MCI,
+	EXCHCODE_MCT,
+	EXCHCODE_MCX,
+	EXCHCODE_MD,
+	EXCHCODE_MDE,
+	EXCHCODE_MDX,
+	EXCHCODE_ME,
+	EXCHCODE_MELBOURNE,
+	EXCHCODE_MENDOZA,
+	EXCHCODE_MERJ,
+	EXCHCODE_MERVAL,
+	EXCHCODE_MET,
+	EXCHCODE_MEXICO,
+	EXCHCODE_MF,
+	EXCHCODE_MFA,
+	EXCHCODE_MFM,
+	EXCHCODE_MFP,
+	EXCHCODE_MGE,
+	EXCHCODE_MI,
+	EXCHCODE_MICEX,
+	EXCHCODE_MICEXA1,
+	EXCHCODE_MICEXA2,
+	EXCHCODE_MICEXB,
+	EXCHCODE_MICEXD,
+	EXCHCODE_MICEXUnlisted,
+	EXCHCODE_MICEXV,
+	EXCHCODE_MIF,
+	EXCHCODE_MIL,
+	EXCHCODE_MILAN,
+	EXCHCODE_MK,
+	EXCHCODE_MM,
+	EXCHCODE_MN,
+	EXCHCODE_MO,
+	EXCHCODE_MOEXLevel1,
+	EXCHCODE_MOEXLevel2,
+	EXCHCODE_MOEXLevel3,
+	EXCHCODE_MONGOLIA,
+	EXCHCODE_MONTENEGRO,
+	EXCHCODE_MONTEVIDEO,
+	EXCHCODE_MOSCOW,
+	EXCHCODE_MOT,
+	EXCHCODE_MOZAMBIQUE,
+	EXCHCODE_MP,
+	EXCHCODE_MS,
+	EXCHCODE_MSE,
+	EXCHCODE_MSX,
+	EXCHCODE_MT,
+	EXCHCODE_MTSAMSTERDAM,
+	EXCHCODE_MTSAustria,
+	EXCHCODE_MTSBELGIUM,
+	EXCHCODE_MTSFRANCE,
+	EXCHCODE_MTSFinland,
+	EXCHCODE_MTSGREECE,
+	EXCHCODE_MTSGermany,
+	EXCHCODE_MTSIRELAND,
+	EXCHCODE_MTSIsrael,
+	EXCHCODE_MTSPORTUGAL,
+	EXCHCODE_MTSSpA,
+	EXCHCODE_MTSSpain,
+	EXCHCODE_MU,
+	EXCHCODE_MUMBAI,
+	EXCHCODE_MUNICH,
+	EXCHCODE_MUSCATSECSMKT,
+	EXCHCODE_MV,
+	EXCHCODE_MW,
+	EXCHCODE_MX,
+	EXCHCODE_MY,
+	EXCHCODE_MZ,
+	EXCHCODE_N2X,
+	EXCHCODE_NA,
+	EXCHCODE_NAGOYA,
+	EXCHCODE_NAIROBI,
+	EXCHCODE_NAMIBIA,
+	EXCHCODE_NANTES,
+	EXCHCODE_NASDAQ,
+	EXCHCODE_NASDAQDUBAI,
+	EXCHCODE_NASDAQOMXPHLX,
+	EXCHCODE_NASDAQNCM,
+	EXCHCODE_NASDAQNGM,
+	EXCHCODE_NASDAQNGS,
+	EXCHCODE_NB,
+	EXCHCODE_NC,
+	EXCHCODE_ND,
+	EXCHCODE_NDM,
+	EXCHCODE_NDX,
+	EXCHCODE_NE,
+	EXCHCODE_NEWYORK,
+	EXCHCODE_NEWZEALAND,
+	EXCHCODE_NF,
+	EXCHCODE_NFE,
+	EXCHCODE_NFX,
+	EXCHCODE_NG,
+	EXCHCODE_NGC,
+	EXCHCODE_NGM,
+	EXCHCODE_NI,
+	EXCHCODE_NIGERIA,
+	EXCHCODE_NJ,
+	EXCHCODE_NK,
+	EXCHCODE_NL,
+	EXCHCODE_NLX,
+	EXCHCODE_NM,
+	EXCHCODE_NN,
+	EXCHCODE_NO,
+	EXCHCODE_NOMX1stNorthC,
+	EXCHCODE_NOMX1stNorthF,
+	EXCHCODE_NOMX1stNorthS,
+	EXCHCODE_NOMXCOPENHAGEN,
+	EXCHCODE_NOMXHELSINKI,
+	EXCHCODE_NOMXICELAND,
+	EXCHCODE_NOMXRIGA,
+	EXCHCODE_NOMXSTOCKHOLM,
+	EXCHCODE_NOMXTALLINN,
+	EXCHCODE_NOMXVILNIUS,
+	EXCHCODE_NORDICABM,
+	EXCHCODE_NOTLISTED,
+	EXCHCODE_NOUVEAUMARCHE,
+	EXCHCODE_NP,
+	EXCHCODE_NPE,
+	EXCHCODE_NQ,
+	EXCHCODE_NQL,
+	EXCHCODE_NR,
+	EXCHCODE_NS,
+	EXCHCODE_NSE,
+	EXCHCODE_NSEAustralia,
+	EXCHCODE_NSEIFSC,
+	EXCHCODE_NSEINDIA,
+	EXCHCODE_NSEL,
+	EXCHCODE_NSEL1î,
+	EXCHCODE_NSELVÉ,
+	EXCHCODE_NSELh,
+	EXCHCODE_NSELß,
+	EXCHCODE_NT,
+	EXCHCODE_NV,
+	EXCHCODE_NW,
+	EXCHCODE_NX,
+	EXCHCODE_NY,
+	EXCHCODE_NYB,
+	EXCHCODE_NYF,
+	EXCHCODE_NYM,
+	EXCHCODE_NYSEAMERICAN,
+	EXCHCODE_NYSEARCA,
+	EXCHCODE_NYSEBONDMATCH,
+	EXCHCODE_NZ,
+	EXCHCODE_NZX,
+	EXCHCODE_OBX,
+	EXCHCODE_OC,
+	EXCHCODE_OCG,
+	EXCHCODE_ODE,
+	EXCHCODE_OF,
+	EXCHCODE_OKCN,
+	EXCHCODE_OKEX,
+	EXCHCODE_OM,
+	EXCHCODE_OMEGACANADAATS,
+	EXCHCODE_OMP,
+	EXCHCODE_OS,
+	EXCHCODE_OSAKA,
+	EXCHCODE_OSAKA2,
+	EXCHCODE_OSE,
+	EXCHCODE_OSLO,
+	EXCHCODE_OTCBB,
+	EXCHCODE_OTCUS,
+	EXCHCODE_OU,
+	EXCHCODE_P2,
+	EXCHCODE_PA,
+	EXCHCODE_PAKISTAN,
+	EXCHCODE_PALESTINE,
+	EXCHCODE_PANAMA,
+	EXCHCODE_PB,
+	EXCHCODE_PBT,
+	EXCHCODE_PC,
+	EXCHCODE_PD,
+	EXCHCODE_PDEx,
+	EXCHCODE_PE,
+	EXCHCODE_PEX,
+	EXCHCODE_PF,
+	EXCHCODE_PFTS,
+	EXCHCODE_PG,
+	EXCHCODE_PHILIPPINES,
+	EXCHCODE_PHL,
+	EXCHCODE_PINKSHEETS,
+	EXCHCODE_PK,
+	EXCHCODE_PL,
+	EXCHCODE_PLX,
+	EXCHCODE_PM,
+	EXCHCODE_PMI,
+	EXCHCODE_PMX,
+	EXCHCODE_PN,
+	EXCHCODE_PNX,
+	EXCHCODE_PO,
+	EXCHCODE_POLO,
+	EXCHCODE_PORTMORESBY,
+	EXCHCODE_PORTAL,
+	EXCHCODE_PP,
+	EXCHCODE_PQ,
+	EXCHCODE_PRAGUE,
+	EXCHCODE_PRG,
+	EXCHCODE_PROSECMKTPSM,
+	EXCHCODE_PS,
+	EXCHCODE_PURETRADING,
+	EXCHCODE_PW,
+	EXCHCODE_PX,
+	EXCHCODE_PZ,
+	EXCHCODE_QATAR,
+	EXCHCODE_QD,
+	EXCHCODE_QE,
+	EXCHCODE_QF,
+	EXCHCODE_QG,
+	EXCHCODE_QH,
+	EXCHCODE_QM,
+	EXCHCODE_QN,
+	EXCHCODE_QT,
+	EXCHCODE_QU,
+	EXCHCODE_QUITO,
+	EXCHCODE_QUON,
+	EXCHCODE_QX,
+	EXCHCODE_Quotrix,
+	EXCHCODE_RASDAQ,
+	EXCHCODE_RB,
+	EXCHCODE_RC,
+	EXCHCODE_RE,
+	EXCHCODE_RF,
+	EXCHCODE_RFX,
+	EXCHCODE_RG,
+	EXCHCODE_RIODEJANEIRO,
+	EXCHCODE_RM,
+	EXCHCODE_RN,
+	EXCHCODE_RO,
+	EXCHCODE_ROFEX,
+	EXCHCODE_RP,
+	EXCHCODE_RQ,
+	EXCHCODE_RR,
+	EXCHCODE_RS,
+	EXCHCODE_RT,
+	EXCHCODE_RTS,
+	EXCHCODE_RU,
+	EXCHCODE_RUSSIANTRADING,
+	EXCHCODE_RW,
+	EXCHCODE_RWANDA,
+	EXCHCODE_RX,
+	EXCHCODE_RZ,
+	EXCHCODE_S1,
+	EXCHCODE_S2,
+	EXCHCODE_S3,
+	EXCHCODE_S4,
+	EXCHCODE_SA,
+	EXCHCODE_SAF,
+	EXCHCODE_SANTIAGO,
+	EXCHCODE_SANTODOMINGO,
+	EXCHCODE_SAOPAULO,
+	EXCHCODE_SARAJEVO,
+	EXCHCODE_SAUDIARABIA,
+	EXCHCODE_SB,
+	EXCHCODE_SBA,
+	EXCHCODE_SC,
+	EXCHCODE_SCE,
+	EXCHCODE_SCIEX,
+	EXCHCODE_SCOACHFRANKFURT,
+	EXCHCODE_SD,
+	EXCHCODE_SE,
+	EXCHCODE_SEDEXMilan,
+	EXCHCODE_SEND,
+	EXCHCODE_SF,
+	EXCHCODE_SFE,
+	EXCHCODE_SG,
+	EXCHCODE_SGX,
+	EXCHCODE_SGXST,
+	EXCHCODE_SH,
+	EXCHCODE_SHANGHAI,
+	EXCHCODE_SHENZHEN,
+	EXCHCODE_SHF,
+	EXCHCODE_SI,
+	EXCHCODE_SIB,
+	EXCHCODE_SIBE,
+	EXCHCODE_SICEX,
+	EXCHCODE_SINGAPORE,
+	EXCHCODE_SINGAPOREMAINBD,
+	EXCHCODE_SISBEX,
+	EXCHCODE_SIX,
+	EXCHCODE_SIXDigital,
+	EXCHCODE_SIXEuropeLTD,
+	EXCHCODE_SIXSTRUCTURED,
+	EXCHCODE_SIXSwissSP,
+	EXCHCODE_SJ,
+	EXCHCODE_SK,
+	EXCHCODE_SL,
+	EXCHCODE_SLOVAK,
+	EXCHCODE_SM,
+	EXCHCODE_SME,
+	EXCHCODE_SN,
+	EXCHCODE_SO,
+	EXCHCODE_SOP,
+	EXCHCODE_SP,
+	EXCHCODE_SPCEX,
+	EXCHCODE_SPX,
+	EXCHCODE_SQ,
+	EXCHCODE_SR,
+	EXCHCODE_SS,
+	EXCHCODE_SSE,
+	EXCHCODE_ST,
+	EXCHCODE_STMP,
+	EXCHCODE_STRASBOURG,
+	EXCHCODE_STUTTGART,
+	EXCHCODE_SU,
+	EXCHCODE_SUSH,
+	EXCHCODE_SV,
+	EXCHCODE_SW,
+	EXCHCODE_SX,
+	EXCHCODE_SXHA,
+	EXCHCODE_SY,
+	EXCHCODE_SZ,
+	EXCHCODE_StPetersburg,
+	EXCHCODE_T1,
+	EXCHCODE_T2,
+	EXCHCODE_T3,
+	EXCHCODE_TA,
+	EXCHCODE_TAD,
+	EXCHCODE_TAIWAN,
+	EXCHCODE_TASHKENT,
+	EXCHCODE_TAV,
+	EXCHCODE_TB,
+	EXCHCODE_TBIT,
+	EXCHCODE_TBMA,
+	EXCHCODE_TBSPOLAND,
+	EXCHCODE_TC,
+	EXCHCODE_TCC,
+	EXCHCODE_TCM,
+	EXCHCODE_TD,
+	EXCHCODE_TE,
+	EXCHCODE_TEF,
+	EXCHCODE_TEHERAN,
+	EXCHCODE_TELAVIV,
+	EXCHCODE_TF,
+	EXCHCODE_TFE,
+	EXCHCODE_TFX,
+	EXCHCODE_TG,
+	EXCHCODE_TGE,
+	EXCHCODE_TH,
+	EXCHCODE_THAILAND,
+	EXCHCODE_THIRDMKTCORP,
+	EXCHCODE_TI,
+	EXCHCODE_TIDX,
+	EXCHCODE_TISE,
+	EXCHCODE_TJ,
+	EXCHCODE_TK,
+	EXCHCODE_TL,
+	EXCHCODE_TLX,
+	EXCHCODE_TN,
+	EXCHCODE_TO,
+	EXCHCODE_TOKYO,
+	EXCHCODE_TOKYO2,
+	EXCHCODE_TOM,
+	EXCHCODE_TORONTO,
+	EXCHCODE_TP,
+	EXCHCODE_TQ,
+	EXCHCODE_TR,
+	EXCHCODE_TRACE,
+	EXCHCODE_TRADEGATE,
+	EXCHCODE_TRCK,
+	EXCHCODE_TRINIDADTOBAGO,
+	EXCHCODE_TS,
+	EXCHCODE_TSE,
+	EXCHCODE_TSXVENTURE,
+	EXCHCODE_TT,
+	EXCHCODE_TTC,
+	EXCHCODE_TU,
+	EXCHCODE_TUNIS,
+	EXCHCODE_TV,
+	EXCHCODE_TW,
+	EXCHCODE_TX,
+	EXCHCODE_TY,
+	EXCHCODE_TZ,
+	EXCHCODE_Taipei,
+	EXCHCODE_UA,
+	EXCHCODE_UB,
+	EXCHCODE_UC,
+	EXCHCODE_UD,
+	EXCHCODE_UE,
+	EXCHCODE_UF,
+	EXCHCODE_UG,
+	EXCHCODE_UGANDA,
+	EXCHCODE_UH,
+	EXCHCODE_UI,
+	EXCHCODE_UJ,
+	EXCHCODE_UK,
+	EXCHCODE_UKR,
+	EXCHCODE_UKRAINIANEXCH,
+	EXCHCODE_UL,
+	EXCHCODE_UM,
+	EXCHCODE_UN,
+	EXCHCODE_UNKNOWN,
+	EXCHCODE_UO,
+	EXCHCODE_UP,
+	EXCHCODE_UPBT,
+	EXCHCODE_UQ,
+	EXCHCODE_UR,
+	EXCHCODE_URCEX,
+	EXCHCODE_US,
+	EXCHCODE_USE,
+	EXCHCODE_USP2,
+	EXCHCODE_USP3,
+	EXCHCODE_UT,
+	EXCHCODE_UU,
+	EXCHCODE_UV,
+	EXCHCODE_UW,
+	EXCHCODE_UX,
+	EXCHCODE_UY,
+	EXCHCODE_UZ,
+	EXCHCODE_VA,
+	EXCHCODE_VALENCIA,
+	EXCHCODE_VARAZDIN,
+	EXCHCODE_VB,
+	EXCHCODE_VC,
+	EXCHCODE_VE,
+	EXCHCODE_VF,
+	EXCHCODE_VG,
+	EXCHCODE_VH,
+	EXCHCODE_VI,
+	EXCHCODE_VIENNA,
+	EXCHCODE_VJ,
+	EXCHCODE_VK,
+	EXCHCODE_VL,
+	EXCHCODE_VM,
+	EXCHCODE_VN,
+	EXCHCODE_VP,
+	EXCHCODE_VR,
+	EXCHCODE_VS,
+	EXCHCODE_VU,
+	EXCHCODE_VX,
+	EXCHCODE_VY,
+	EXCHCODE_Vorvel,
+	EXCHCODE_WARSAW,
+	EXCHCODE_WBA,
+	EXCHCODE_WCE,
+	EXCHCODE_WSE,
+	EXCHCODE_WT,
+	EXCHCODE_WTB,
+	EXCHCODE_WX,
+	EXCHCODE_X1,
+	EXCHCODE_X2,
+	EXCHCODE_X9,
+	EXCHCODE_XA,
+	EXCHCODE_XB,
+	EXCHCODE_XBTR,
+	EXCHCODE_XC,
+	EXCHCODE_XD,
+	EXCHCODE_XE,
+	EXCHCODE_XETRA,
+	EXCHCODE_XF,
+	EXCHCODE_XG,
+	EXCHCODE_XH,
+	EXCHCODE_XI,
+	EXCHCODE_XJ,
+	EXCHCODE_XK,
+	EXCHCODE_XL,
+	EXCHCODE_XM,
+	EXCHCODE_XN,
+	EXCHCODE_XO,
+	EXCHCODE_XP,
+	EXCHCODE_XQ,
+	EXCHCODE_XR,
+	EXCHCODE_XS,
+	EXCHCODE_XT,
+	EXCHCODE_XU,
+	EXCHCODE_XV,
+	EXCHCODE_XW,
+	EXCHCODE_XX,
+	EXCHCODE_XY,
+	EXCHCODE_XZ,
+	EXCHCODE_YC,
+	EXCHCODE_YELLOWSHEETS,
+	EXCHCODE_YLX,
+	EXCHCODE_YOBT,
+	EXCHCODE_YSE,
+	EXCHCODE_ZA,
+	EXCHCODE_ZAGREB,
+	EXCHCODE_ZAIF,
+	EXCHCODE_ZB,
+	EXCHCODE_ZBCN,
+	EXCHCODE_ZC,
+	EXCHCODE_ZCE,
+	EXCHCODE_ZG,
+	EXCHCODE_ZH,
+	EXCHCODE_ZIMBABWE,
+	EXCHCODE_ZL,
+	EXCHCODE_ZS,
+	EXCHCODE_ZU,
+	EXCHCODE_bbox,
+	EXCHCODE_bbsp,
+	EXCHCODE_bequ,
+	EXCHCODE_bfly,
+	EXCHCODE_bfnx,
+	EXCHCODE_bfrx,
+	EXCHCODE_bgon,
+	EXCHCODE_binc,
+	EXCHCODE_blc2,
+	EXCHCODE_blcr,
+	EXCHCODE_bnce,
+	EXCHCODE_bnus,
+	EXCHCODE_bpnd,
+	EXCHCODE_btba,
+	EXCHCODE_btcb,
+	EXCHCODE_bthb,
+	EXCHCODE_btmx,
+	EXCHCODE_btrk,
+	EXCHCODE_btrx,
+	EXCHCODE_btso,
+	EXCHCODE_cbse,
+	EXCHCODE_ccck,
+	EXCHCODE_cexi,
+	EXCHCODE_cnex,
+	EXCHCODE_cone,
+	EXCHCODE_crco,
+	EXCHCODE_crv2,
+	EXCHCODE_cucy,
+	EXCHCODE_curv,
+	EXCHCODE_delt,
+	EXCHCODE_drbt,
+	EXCHCODE_eris,
+	EXCHCODE_gmni,
+	EXCHCODE_hitb,
+	EXCHCODE_huob,
+	EXCHCODE_indr,
+	EXCHCODE_itbi,
+	EXCHCODE_kcon,
+	EXCHCODE_korb,
+	EXCHCODE_krkn,
+	EXCHCODE_lmax,
+	EXCHCODE_mexc,
+	EXCHCODE_nvdx,
+	EXCHCODE_okcn,
+	EXCHCODE_okex,
+	EXCHCODE_oslx,
+	EXCHCODE_pksp,
+	EXCHCODE_polo,
+	EXCHCODE_qsp3,
+	EXCHCODE_stmp,
+	EXCHCODE_sush,
+	EXCHCODE_sxha,
+	EXCHCODE_upbt,
+	EXCHCODE_usp2,
+	EXCHCODE_usp3,
+	EXCHCODE_yobt,
+	EXCHCODE_zaif,
+	EXCHCODE_zbcn,
+}
+
+// Number of generated exchCode values
+func ExchCodeCount() int {
+	return len(exchCodeValues)
+}
+
+// Whether no exchCode value was generated, e.g. after a broken run
+func ExchCodeIsEmpty() bool {
+	return len(exchCodeValues) == 0
+}
+
+// Generated exchCode values, sorted
+func ExchCodes() []string {
+	return slices.Clone(exchCodeValues)
+}
@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of idType, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type IDType string
+
 const (
 	IDTYPE_BARCLAYS_TICKER                = "BARCLAYS_TICKER"
 	IDTYPE_BASE_TICKER                    = "BASE_TICKER"
@@ -32,3 +38,49 @@ const (
 	IDTYPE_UNIQUE_ID_FUT_OPT              = "UNIQUE_ID_FUT_OPT"
 	IDTYPE_VENDOR_INDEX_CODE              = "VENDOR_INDEX_CODE"
 )
+
+var idTypeValues = []string{
+	IDTYPE_BARCLAYS_TICKER,
+	IDTYPE_BASE_TICKER,
+	IDTYPE_COMPOSITE_ID_BB_GLOBAL,
+	IDTYPE_ID_BB,
+	IDTYPE_ID_BB_8_CHR,
+	IDTYPE_ID_BB_GLOBAL,
+	IDTYPE_ID_BB_GLOBAL_SHARE_CLASS_LEVEL,
+	IDTYPE_ID_BB_SEC_NUM_DES,
+	IDTYPE_ID_BB_UNIQUE,
+	IDTYPE_ID_CINS,
+	IDTYPE_ID_COMMON,
+	IDTYPE_ID_CUSIP,
+	IDTYPE_ID_CUSIP_8_CHR,
+	IDTYPE_ID_EXCH_SYMBOL,
+	IDTYPE_ID_FULL_EXCHANGE_SYMBOL,
+	IDTYPE_ID_ISIN,
+	IDTYPE_ID_ITALY,
+	IDTYPE_ID_SEDOL,
+	IDTYPE_ID_SHORT_CODE,
+	IDTYPE_ID_TRACE,
+	IDTYPE_ID_WERTPAPIER,
+	IDTYPE_OCC_SYMBOL,
+	IDTYPE_OPRA_SYMBOL,
+	IDTYPE_TICKER,
+	IDTYPE_TRADEBOOK_TICKER,
+	IDTYPE_TRADING_SYSTEM_IDENTIFIER,
+	IDTYPE_UNIQUE_ID_FUT_OPT,
+	IDTYPE_VENDOR_INDEX_CODE,
+}
+
+// Number of generated idType values
+func IDTypeCount() int {
+	return len(idTypeValues)
+}
+
+// Whether no idType value was generated, e.g. after a broken run
+func IDTypeIsEmpty() bool {
+	return len(idTypeValues) == 0
+}
+
+// Generated idType values, sorted
+func IDTypeValues() []string {
+	return slices.Clone(idTypeValues)
+}
@@ -0,0 +1,60 @@
+package constants
+
+// An idType and its human-readable label, e.g. for a dropdown
+type IDTypeInfo struct {
+	Value       string
+	Description string
+}
+
+// Labels of the idTypes, after https://www.openfigi.com/api#v3-idType-values.
+// Keep in sync with the generated idType values.
+var idTypeDescriptions = map[string]string{
+	IDTYPE_BARCLAYS_TICKER:                "Barclays Ticker - Ticker of a Bloomberg Barclays index",
+	IDTYPE_BASE_TICKER:                    "Base Ticker - Indistinct ticker that may be linked to multiple instruments",
+	IDTYPE_COMPOSITE_ID_BB_GLOBAL:         "Composite FIGI - Links the venue-level FIGIs of an instrument within a country",
+	IDTYPE_ID_BB:                          "Bloomberg ID - Legacy Bloomberg identifier",
+	IDTYPE_ID_BB_8_CHR:                    "Bloomberg ID (8 Characters) - Legacy Bloomberg identifier",
+	IDTYPE_ID_BB_GLOBAL:                   "FIGI - Financial Instrument Global Identifier",
+	IDTYPE_ID_BB_GLOBAL_SHARE_CLASS_LEVEL: "Share Class FIGI - Links an instrument traded in more than one country",
+	IDTYPE_ID_BB_SEC_NUM_DES:              "Security ID Number Description - Ticker-like descriptor with additional metadata",
+	IDTYPE_ID_BB_UNIQUE:                   "Unique Bloomberg Identifier - Legacy internal Bloomberg identifier",
+	IDTYPE_ID_CINS:                        "CINS - CUSIP International Numbering System",
+	IDTYPE_ID_COMMON:                      "Common Code - Nine-digit identification number",
+	IDTYPE_ID_CUSIP:                       "CUSIP - Committee on Uniform Securities Identification Procedures",
+	IDTYPE_ID_CUSIP_8_CHR:                 "CUSIP (8 Characters) - CUSIP without its check digit",
+	IDTYPE_ID_EXCH_SYMBOL:                 "Exchange Symbol - Local exchange security symbol",
+	IDTYPE_ID_FULL_EXCHANGE_SYMBOL:        "Full Exchange Symbol - Exchange symbol of futures, options and indices",
+	IDTYPE_ID_ISIN:                        "ISIN - International Securities Identification Number",
+	IDTYPE_ID_ITALY:                       "Italian Identifier Number - Five or six-digit Italian identification number",
+	IDTYPE_ID_SEDOL:                       "SEDOL - Stock Exchange Daily Official List",
+	IDTYPE_ID_SHORT_CODE:                  "Short Code - Venue code of fixed income instruments traded in Asia",
+	IDTYPE_ID_TRACE:                       "TRACE - FINRA TRACE identifier of a fixed income instrument",
+	IDTYPE_ID_WERTPAPIER:                  "WKN - Wertpapierkennnummer, German securities identification code",
+	IDTYPE_OCC_SYMBOL:                     "OCC Symbol - Options Clearing Corporation symbol of a U.S. option",
+	IDTYPE_OPRA_SYMBOL:                    "OPRA Symbol - Options Price Reporting Authority symbol of a U.S. option",
+	IDTYPE_TICKER:                         "Ticker - Identifier of an instrument in common usage",
+	IDTYPE_TRADEBOOK_TICKER:               "Tradebook Ticker - Ticker on Bloomberg Tradebook",
+	IDTYPE_TRADING_SYSTEM_IDENTIFIER:      "Trading System Identifier - Identifier on the source trading system",
+	IDTYPE_UNIQUE_ID_FUT_OPT:              "Unique ID for Future Option - Bloomberg unique ticker of futures and options",
+	IDTYPE_VENDOR_INDEX_CODE:              "Vendor Index Code - Code of an index assigned by its vendor",
+}
+
+// Every generated idType with its label, sorted by value. An idType without
+// a known label has its value as description.
+//
+// Usage:
+//
+//	for _, idType := range IDTypes() {
+//		fmt.Printf("<option value=%q>%s</option>\n", idType.Value, idType.Description)
+//	}
+func IDTypes() []IDTypeInfo {
+	infos := make([]IDTypeInfo, len(idTypeValues))
+	for i, value := range idTypeValues {
+		description, ok := idTypeDescriptions[value]
+		if !ok {
+			description = value
+		}
+		infos[i] = IDTypeInfo{Value: value, Description: description}
+	}
+	return infos
+}
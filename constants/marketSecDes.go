@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of marketSecDes, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type MarketSecDes string
+
 const (
 	MARKETSECDES_Comdty = "Comdty"
 	MARKETSECDES_Corp   = "Corp"
@@ -14,3 +20,31 @@ const (
 	MARKETSECDES_Muni   = "Muni"
 	MARKETSECDES_Pfd    = "Pfd"
 )
+
+var marketSecDesValues = []string{
+	MARKETSECDES_Comdty,
+	MARKETSECDES_Corp,
+	MARKETSECDES_Curncy,
+	MARKETSECDES_Equity,
+	MARKETSECDES_Govt,
+	MARKETSECDES_Index,
+	MARKETSECDES_MMkt,
+	MARKETSECDES_Mtge,
+	MARKETSECDES_Muni,
+	MARKETSECDES_Pfd,
+}
+
+// Number of generated marketSecDes values
+func MarketSecDesCount() int {
+	return len(marketSecDesValues)
+}
+
+// Whether no marketSecDes value was generated, e.g. after a broken run
+func MarketSecDesIsEmpty() bool {
+	return len(marketSecDesValues) == 0
+}
+
+// Generated marketSecDes values, sorted
+func MarketSecDesValues() []string {
+	return slices.Clone(marketSecDesValues)
+}
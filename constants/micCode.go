@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of micCode, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type MicCode string
+
 const (
 	MICCODE_A2XX = "A2XX"
 	MICCODE_ACEX = "ACEX"
@@ -476,3 +482,493 @@ const (
 	MICCODE_YYYY = "YYYY"
 	MICCODE_ZFXM = "ZFXM"
 )
+
+var micCodeValues = []string{
+	MICCODE_A2XX,
+	MICCODE_ACEX,
+	MICCODE_ADRK,
+	MICCODE_AFET,
+	MICCODE_AIXK,
+	MICCODE_AMTS,
+	MICCODE_AMXO,
+	MICCODE_APEX,
+	MICCODE_APXL,
+	MICCODE_AQEU,
+	MICCODE_AQSE,
+	MICCODE_AQXE,
+	MICCODE_ARCO,
+	MICCODE_ARCX,
+	MICCODE_ARTX,
+	MICCODE_ASXP,
+	MICCODE_BATE,
+	MICCODE_BATO,
+	MICCODE_BATS,
+	MICCODE_BATY,
+	MICCODE_BCSE,
+	MICCODE_BEUE,
+	MICCODE_BIVA,
+	MICCODE_BJSE,
+	MICCODE_BLOX,
+	MICCODE_BMFM,
+	MICCODE_BMTF,
+	MICCODE_BMTS,
+	MICCODE_BOAT,
+	MICCODE_BOTC,
+	MICCODE_BSEX,
+	MICCODE_BTFE,
+	MICCODE_BURM,
+	MICCODE_BVCA,
+	MICCODE_BVMF,
+	MICCODE_C2OX,
+	MICCODE_CAPA,
+	MICCODE_CCFX,
+	MICCODE_CEDX,
+	MICCODE_CEUX,
+	MICCODE_CHIA,
+	MICCODE_CHIC,
+	MICCODE_CHIJ,
+	MICCODE_CHIX,
+	MICCODE_CMED,
+	MICCODE_CSE2,
+	MICCODE_DGCX,
+	MICCODE_DIFX,
+	MICCODE_DKED,
+	MICCODE_DKTC,
+	MICCODE_DSMD,
+	MICCODE_DUMX,
+	MICCODE_EBMX,
+	MICCODE_ECEU,
+	MICCODE_EDGA,
+	MICCODE_EDGO,
+	MICCODE_EDGX,
+	MICCODE_EMLD,
+	MICCODE_EMTF,
+	MICCODE_EMTS,
+	MICCODE_ENAX,
+	MICCODE_EPRL,
+	MICCODE_ERIS,
+	MICCODE_ETLX,
+	MICCODE_EUCH,
+	MICCODE_EUWX,
+	MICCODE_EXGM,
+	MICCODE_FISH,
+	MICCODE_FMTS,
+	MICCODE_FNDK,
+	MICCODE_FNFI,
+	MICCODE_FNFT,
+	MICCODE_FNIS,
+	MICCODE_FNSE,
+	MICCODE_FRAB,
+	MICCODE_FREX,
+	MICCODE_GBOT,
+	MICCODE_GEMX,
+	MICCODE_GMEG,
+	MICCODE_GMNI,
+	MICCODE_GSXL,
+	MICCODE_HKME,
+	MICCODE_HMTF,
+	MICCODE_HOTC,
+	MICCODE_HSTC,
+	MICCODE_ICDX,
+	MICCODE_ICEL,
+	MICCODE_ICXL,
+	MICCODE_IEPA,
+	MICCODE_IEXG,
+	MICCODE_IFAD,
+	MICCODE_IFCA,
+	MICCODE_IFED,
+	MICCODE_IFEU,
+	MICCODE_IFLL,
+	MICCODE_IFLO,
+	MICCODE_IFLX,
+	MICCODE_IFSG,
+	MICCODE_IFUS,
+	MICCODE_IINX,
+	MICCODE_IMTS,
+	MICCODE_INSE,
+	MICCODE_LEUE,
+	MICCODE_LICA,
+	MICCODE_LIQU,
+	MICCODE_LNEQ,
+	MICCODE_LSSI,
+	MICCODE_LTSE,
+	MICCODE_LYNX,
+	MICCODE_MALX,
+	MICCODE_MARF,
+	MICCODE_MATN,
+	MICCODE_MCAD,
+	MICCODE_MCRY,
+	MICCODE_MCXX,
+	MICCODE_MEMX,
+	MICCODE_MFOX,
+	MICCODE_MISX,
+	MICCODE_MOTX,
+	MICCODE_MPRL,
+	MICCODE_MSAX,
+	MICCODE_MTAA,
+	MICCODE_MTAH,
+	MICCODE_MTCH,
+	MICCODE_MTSC,
+	MICCODE_MTSD,
+	MICCODE_MTSF,
+	MICCODE_MUND,
+	MICCODE_MXOP,
+	MICCODE_N2EX,
+	MICCODE_NASX,
+	MICCODE_NCEL,
+	MICCODE_NDEX,
+	MICCODE_NEOE,
+	MICCODE_NEXX,
+	MICCODE_NILX,
+	MICCODE_NORX,
+	MICCODE_NOTC,
+	MICCODE_NZFX,
+	MICCODE_ODXE,
+	MICCODE_OMGA,
+	MICCODE_OMIP,
+	MICCODE_OOTC,
+	MICCODE_OPEX,
+	MICCODE_OTCM,
+	MICCODE_OTXB,
+	MICCODE_PDEX,
+	MICCODE_PFTQ,
+	MICCODE_PFTS,
+	MICCODE_PLPD,
+	MICCODE_PLUS,
+	MICCODE_PURE,
+	MICCODE_ROCO,
+	MICCODE_ROFX,
+	MICCODE_ROTC,
+	MICCODE_RTSX,
+	MICCODE_RUSX,
+	MICCODE_SBIJ,
+	MICCODE_SBIU,
+	MICCODE_SBMF,
+	MICCODE_SEDX,
+	MICCODE_SEND,
+	MICCODE_SGMU,
+	MICCODE_SGMX,
+	MICCODE_SHAR,
+	MICCODE_SHSC,
+	MICCODE_SIMV,
+	MICCODE_SMEX,
+	MICCODE_SPIM,
+	MICCODE_SZSC,
+	MICCODE_TBSP,
+	MICCODE_TFEX,
+	MICCODE_TOMX,
+	MICCODE_TQEX,
+	MICCODE_TREA,
+	MICCODE_TREU,
+	MICCODE_TRNL,
+	MICCODE_TRPX,
+	MICCODE_TRQX,
+	MICCODE_TWEA,
+	MICCODE_TWEM,
+	MICCODE_UKEX,
+	MICCODE_WDER,
+	MICCODE_WMTF,
+	MICCODE_XADE,
+	MICCODE_XADF,
+	MICCODE_XADS,
+	MICCODE_XAIM,
+	MICCODE_XALG,
+	MICCODE_XAMM,
+	MICCODE_XAMS,
+	MICCODE_XAPA,
+	MICCODE_XARM,
+	MICCODE_XASE,
+	MICCODE_XASX,
+	MICCODE_XATH,
+	MICCODE_XATS,
+	MICCODE_XATX,
+	MICCODE_XBAA,
+	MICCODE_XBAB,
+	MICCODE_XBAH,
+	MICCODE_XBAN,
+	MICCODE_XBAR,
+	MICCODE_XBBJ,
+	MICCODE_XBCL,
+	MICCODE_XBCM,
+	MICCODE_XBCV,
+	MICCODE_XBCX,
+	MICCODE_XBDA,
+	MICCODE_XBDV,
+	MICCODE_XBEL,
+	MICCODE_XBER,
+	MICCODE_XBES,
+	MICCODE_XBEY,
+	MICCODE_XBIL,
+	MICCODE_XBKK,
+	MICCODE_XBLB,
+	MICCODE_XBLN,
+	MICCODE_XBNV,
+	MICCODE_XBOG,
+	MICCODE_XBOL,
+	MICCODE_XBOM,
+	MICCODE_XBOS,
+	MICCODE_XBOT,
+	MICCODE_XBOX,
+	MICCODE_XBRA,
+	MICCODE_XBRD,
+	MICCODE_XBRN,
+	MICCODE_XBRU,
+	MICCODE_XBRV,
+	MICCODE_XBSD,
+	MICCODE_XBSE,
+	MICCODE_XBTR,
+	MICCODE_XBUD,
+	MICCODE_XBUE,
+	MICCODE_XBUL,
+	MICCODE_XBVC,
+	MICCODE_XBVM,
+	MICCODE_XBVR,
+	MICCODE_XBXO,
+	MICCODE_XCAI,
+	MICCODE_XCAS,
+	MICCODE_XCAY,
+	MICCODE_XCBF,
+	MICCODE_XCBO,
+	MICCODE_XCBT,
+	MICCODE_XCCX,
+	MICCODE_XCEC,
+	MICCODE_XCEG,
+	MICCODE_XCFE,
+	MICCODE_XCHG,
+	MICCODE_XCHI,
+	MICCODE_XCIE,
+	MICCODE_XCIS,
+	MICCODE_XCME,
+	MICCODE_XCNQ,
+	MICCODE_XCOL,
+	MICCODE_XCSE,
+	MICCODE_XCSX,
+	MICCODE_XCUE,
+	MICCODE_XCX2,
+	MICCODE_XCXD,
+	MICCODE_XCYS,
+	MICCODE_XDAR,
+	MICCODE_XDCE,
+	MICCODE_XDES,
+	MICCODE_XDFM,
+	MICCODE_XDHA,
+	MICCODE_XDMI,
+	MICCODE_XDPA,
+	MICCODE_XDRF,
+	MICCODE_XDSE,
+	MICCODE_XDSX,
+	MICCODE_XDUB,
+	MICCODE_XDUS,
+	MICCODE_XECM,
+	MICCODE_XECS,
+	MICCODE_XEEE,
+	MICCODE_XELX,
+	MICCODE_XEMD,
+	MICCODE_XEQT,
+	MICCODE_XETR,
+	MICCODE_XEUE,
+	MICCODE_XEUR,
+	MICCODE_XFEX,
+	MICCODE_XFKA,
+	MICCODE_XFM,
+	MICCODE_XFRA,
+	MICCODE_XGAT,
+	MICCODE_XGHA,
+	MICCODE_XGME,
+	MICCODE_XGSE,
+	MICCODE_XGTG,
+	MICCODE_XGUA,
+	MICCODE_XHAM,
+	MICCODE_XHAN,
+	MICCODE_XHEL,
+	MICCODE_XHFT,
+	MICCODE_XHKF,
+	MICCODE_XHKG,
+	MICCODE_XHNF,
+	MICCODE_XHNX,
+	MICCODE_XICE,
+	MICCODE_XICX,
+	MICCODE_XIDX,
+	MICCODE_XIMC,
+	MICCODE_XINE,
+	MICCODE_XIQS,
+	MICCODE_XISA,
+	MICCODE_XIST,
+	MICCODE_XISX,
+	MICCODE_XJAM,
+	MICCODE_XJAS,
+	MICCODE_XJSE,
+	MICCODE_XKAC,
+	MICCODE_XKAR,
+	MICCODE_XKAZ,
+	MICCODE_XKBT,
+	MICCODE_XKEM,
+	MICCODE_XKFB,
+	MICCODE_XKFE,
+	MICCODE_XKHA,
+	MICCODE_XKIS,
+	MICCODE_XKLS,
+	MICCODE_XKON,
+	MICCODE_XKOS,
+	MICCODE_XKRX,
+	MICCODE_XKSE,
+	MICCODE_XKUW,
+	MICCODE_XLAO,
+	MICCODE_XLDN,
+	MICCODE_XLFX,
+	MICCODE_XLIM,
+	MICCODE_XLIS,
+	MICCODE_XLIT,
+	MICCODE_XLJU,
+	MICCODE_XLME,
+	MICCODE_XLOD,
+	MICCODE_XLON,
+	MICCODE_XLUS,
+	MICCODE_XLUX,
+	MICCODE_XMAB,
+	MICCODE_XMAD,
+	MICCODE_XMAE,
+	MICCODE_XMAL,
+	MICCODE_XMAN,
+	MICCODE_XMAT,
+	MICCODE_XMAU,
+	MICCODE_XMCE,
+	MICCODE_XMDS,
+	MICCODE_XMEV,
+	MICCODE_XMEX,
+	MICCODE_XMGE,
+	MICCODE_XMIO,
+	MICCODE_XMNT,
+	MICCODE_XMNX,
+	MICCODE_XMOC,
+	MICCODE_XMOD,
+	MICCODE_XMOL,
+	MICCODE_XMON,
+	MICCODE_XMOS,
+	MICCODE_XMOT,
+	MICCODE_XMPW,
+	MICCODE_XMRV,
+	MICCODE_XMSW,
+	MICCODE_XMTB,
+	MICCODE_XMUN,
+	MICCODE_XMUS,
+	MICCODE_XNAI,
+	MICCODE_XNAM,
+	MICCODE_XNAS,
+	MICCODE_XNCD,
+	MICCODE_XNCM,
+	MICCODE_XNDQ,
+	MICCODE_XNDX,
+	MICCODE_XNEC,
+	MICCODE_XNEP,
+	MICCODE_XNGM,
+	MICCODE_XNGO,
+	MICCODE_XNGS,
+	MICCODE_XNIM,
+	MICCODE_XNKS,
+	MICCODE_XNLX,
+	MICCODE_XNMS,
+	MICCODE_XNSA,
+	MICCODE_XNSE,
+	MICCODE_XNYM,
+	MICCODE_XNYS,
+	MICCODE_XNZE,
+	MICCODE_XOAM,
+	MICCODE_XOCH,
+	MICCODE_XOPV,
+	MICCODE_XOSE,
+	MICCODE_XOSL,
+	MICCODE_XOTC,
+	MICCODE_XPAE,
+	MICCODE_XPAR,
+	MICCODE_XPBT,
+	MICCODE_XPHL,
+	MICCODE_XPHS,
+	MICCODE_XPIC,
+	MICCODE_XPOM,
+	MICCODE_XPOR,
+	MICCODE_XPOS,
+	MICCODE_XPOW,
+	MICCODE_XPRA,
+	MICCODE_XPSX,
+	MICCODE_XPTY,
+	MICCODE_XQMH,
+	MICCODE_XQTX,
+	MICCODE_XQUI,
+	MICCODE_XRAS,
+	MICCODE_XRBM,
+	MICCODE_XRIS,
+	MICCODE_XRMZ,
+	MICCODE_XROS,
+	MICCODE_XSAF,
+	MICCODE_XSAM,
+	MICCODE_XSAP,
+	MICCODE_XSAT,
+	MICCODE_XSAU,
+	MICCODE_XSBI,
+	MICCODE_XSCE,
+	MICCODE_XSDX,
+	MICCODE_XSEC,
+	MICCODE_XSES,
+	MICCODE_XSFE,
+	MICCODE_XSGE,
+	MICCODE_XSGO,
+	MICCODE_XSHE,
+	MICCODE_XSHG,
+	MICCODE_XSIM,
+	MICCODE_XSMP,
+	MICCODE_XSPS,
+	MICCODE_XSRM,
+	MICCODE_XSSC,
+	MICCODE_XSSE,
+	MICCODE_XSTC,
+	MICCODE_XSTE,
+	MICCODE_XSTO,
+	MICCODE_XSTU,
+	MICCODE_XSVA,
+	MICCODE_XSWA,
+	MICCODE_XSWX,
+	MICCODE_XTAE,
+	MICCODE_XTAF,
+	MICCODE_XTAI,
+	MICCODE_XTAL,
+	MICCODE_XTEH,
+	MICCODE_XTFF,
+	MICCODE_XTKO,
+	MICCODE_XTKS,
+	MICCODE_XTKT,
+	MICCODE_XTRN,
+	MICCODE_XTSE,
+	MICCODE_XTSX,
+	MICCODE_XTUN,
+	MICCODE_XUBS,
+	MICCODE_XUGA,
+	MICCODE_XULA,
+	MICCODE_XUSE,
+	MICCODE_XVAL,
+	MICCODE_XVPA,
+	MICCODE_XVTX,
+	MICCODE_XWAR,
+	MICCODE_XWBO,
+	MICCODE_XZAG,
+	MICCODE_XZCE,
+	MICCODE_XZIM,
+	MICCODE_YLDX,
+	MICCODE_YYYY,
+	MICCODE_ZFXM,
+}
+
+// Number of generated micCode values
+func MicCodeCount() int {
+	return len(micCodeValues)
+}
+
+// Whether no micCode value was generated, e.g. after a broken run
+func MicCodeIsEmpty() bool {
+	return len(micCodeValues) == 0
+}
+
+// Generated micCode values, sorted
+func MicCodes() []string {
+	return slices.Clone(micCodeValues)
+}
@@ -0,0 +1,11 @@
+package constants
+
+// Whether mapping with idType requires `securityType2`:
+// `BASE_TICKER` and `ID_EXCH_SYMBOL`.
+//
+// Usage:
+//
+//	if IDTypeRequiresSecurityType2(idType) { ... } // Mark the field as required
+func IDTypeRequiresSecurityType2(idType string) bool {
+	return idType == IDTYPE_BASE_TICKER || idType == IDTYPE_ID_EXCH_SYMBOL
+}
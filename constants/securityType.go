@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of securityType, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type SecurityType string
+
 const (
 	SECURITYTYPE_ABSAuto                    = "ABS Auto"
 	SECURITYTYPE_ABSCard                    = "ABS Card"
@@ -13,17 +19,7 @@ const (
 	SECURITYTYPE_ADJUSTABLE                 = "ADJUSTABLE"
 	SECURITYTYPE_ADJUSTABLEOID              = "ADJUSTABLE, OID"
 	SECURITYTYPE_ADR                        = "ADR"
-	SECURITYTYPE_AgncyABSHome               = "Agncy ABS Home"
-	SECURITYTYPE_AgncyABSOther              = "Agncy ABS Other"
-	SECURITYTYPE_AgncyCMBS                  = "Agncy CMBS"
-	SECURITYTYPE_AgncyCMOFLT                = "Agncy CMO FLT"
-	SECURITYTYPE_AgncyCMOINV                = "Agncy CMO INV"
-	SECURITYTYPE_AgncyCMOIO                 = "Agncy CMO IO"
-	SECURITYTYPE_AgncyCMOOther              = "Agncy CMO Other"
-	SECURITYTYPE_AgncyCMOPO                 = "Agncy CMO PO"
-	SECURITYTYPE_AgncyCMOZ                  = "Agncy CMO Z"
 	SECURITYTYPE_ASSETBASED                 = "ASSET-BASED"
-	SECURITYTYPE_AssetBased                 = "Asset-Based"
 	SECURITYTYPE_ASSETBASEDBRIDGE           = "ASSET-BASED BRIDGE"
 	SECURITYTYPE_ASSETBASEDBRIDGEREV        = "ASSET-BASED BRIDGE REV"
 	SECURITYTYPE_ASSETBASEDBRIDGETERM       = "ASSET-BASED BRIDGE TERM"
@@ -39,6 +35,16 @@ const (
 	SECURITYTYPE_AUSTRALIAN                 = "AUSTRALIAN"
 	SECURITYTYPE_AUSTRALIANCD               = "AUSTRALIAN CD"
 	SECURITYTYPE_AUSTRALIANCP               = "AUSTRALIAN CP"
+	SECURITYTYPE_AgncyABSHome               = "Agncy ABS Home"
+	SECURITYTYPE_AgncyABSOther              = "Agncy ABS Other"
+	SECURITYTYPE_AgncyCMBS                  = "Agncy CMBS"
+	SECURITYTYPE_AgncyCMOFLT                = "Agncy CMO FLT"
+	SECURITYTYPE_AgncyCMOINV                = "Agncy CMO INV"
+	SECURITYTYPE_AgncyCMOIO                 = "Agncy CMO IO"
+	SECURITYTYPE_AgncyCMOOther              = "Agncy CMO Other"
+	SECURITYTYPE_AgncyCMOPO                 = "Agncy CMO PO"
+	SECURITYTYPE_AgncyCMOZ                  = "Agncy CMO Z"
+	SECURITYTYPE_AssetBased                 = "Asset-Based"
 	SECURITYTYPE_AustrianCrt                = "Austrian Crt"
 	SECURITYTYPE_BANKACCEPTBILL             = "BANK ACCEPT BILL"
 	SECURITYTYPE_BANKBILL                   = "BANK BILL"
@@ -47,14 +53,11 @@ const (
 	SECURITYTYPE_BANKERSACCEPTANCE          = "BANKERS ACCEPTANCE"
 	SECURITYTYPE_BASISSWAP                  = "BASIS SWAP"
 	SECURITYTYPE_BASISTRADEONCLOSE          = "BASIS TRADE ON CLOSE"
-	SECURITYTYPE_BasketWRT                  = "Basket WRT"
 	SECURITYTYPE_BDR                        = "BDR"
 	SECURITYTYPE_BEARERDEPNOTE              = "BEARER DEP NOTE"
-	SECURITYTYPE_BelgiumCert                = "Belgium Cert"
 	SECURITYTYPE_BELGIUMCP                  = "BELGIUM CP"
 	SECURITYTYPE_BILLOFEXCHANGE             = "BILL OF EXCHANGE"
 	SECURITYTYPE_BILLETAORDRE               = "BILLET A ORDRE"
-	SECURITYTYPE_Bond                       = "Bond"
 	SECURITYTYPE_BRAZILGENERIC              = "BRAZIL GENERIC"
 	SECURITYTYPE_BRAZILIANCDI               = "BRAZILIAN CDI"
 	SECURITYTYPE_BRIDGE                     = "BRIDGE"
@@ -76,18 +79,17 @@ const (
 	SECURITYTYPE_BRIDGEVATTRNCH             = "BRIDGE VAT-TRNCH"
 	SECURITYTYPE_BULLDOG                    = "BULLDOG"
 	SECURITYTYPE_BUTTERFLYSWAP              = "BUTTERFLY SWAP"
+	SECURITYTYPE_BasketWRT                  = "Basket WRT"
+	SECURITYTYPE_BelgiumCert                = "Belgium Cert"
+	SECURITYTYPE_Bond                       = "Bond"
 	SECURITYTYPE_CADINTBEARCP               = "CAD INT BEAR CP"
 	SECURITYTYPE_CALC_INSTRUMENT            = "CALC_INSTRUMENT"
-	SECURITYTYPE_CalendarSpreadOption       = "Calendar Spread Option"
 	SECURITYTYPE_CALLLOANS                  = "CALL LOANS"
 	SECURITYTYPE_CALLABLECP                 = "CALLABLE CP"
 	SECURITYTYPE_CANADIAN                   = "CANADIAN"
-	SECURITYTYPE_Canadian                   = "Canadian"
 	SECURITYTYPE_CANADIANCD                 = "CANADIAN CD"
 	SECURITYTYPE_CANADIANCP                 = "CANADIAN CP"
-	SECURITYTYPE_CanadianDR                 = "Canadian DR"
 	SECURITYTYPE_CAPSFLOORS                 = "CAPS & FLOORS"
-	SECURITYTYPE_CarForward                 = "Car Forward"
 	SECURITYTYPE_CASH                       = "CASH"
 	SECURITYTYPE_CASHFLOW                   = "CASH FLOW"
 	SECURITYTYPE_CASHFLOWOID                = "CASH FLOW, OID"
@@ -100,31 +102,35 @@ const (
 	SECURITYTYPE_CF                         = "CF"
 	SECURITYTYPE_CHILEANCD                  = "CHILEAN CD"
 	SECURITYTYPE_CHILEANDN                  = "CHILEAN DN"
-	SECURITYTYPE_ClosedEndFund              = "Closed-End Fund"
 	SECURITYTYPE_CMBS                       = "CMBS"
-	SECURITYTYPE_CmdtFutWRT                 = "Cmdt Fut WRT"
-	SECURITYTYPE_CmdtIdxWRT                 = "Cmdt Idx WRT"
 	SECURITYTYPE_COLLATCALLNOTE             = "COLLAT CALL NOTE"
 	SECURITYTYPE_COLOMBIANCD                = "COLOMBIAN CD"
 	SECURITYTYPE_COMMERCIALNOTE             = "COMMERCIAL NOTE"
 	SECURITYTYPE_COMMERCIALPAPER            = "COMMERCIAL PAPER"
-	SECURITYTYPE_CommodityIndex             = "Commodity Index"
-	SECURITYTYPE_CommonStock                = "Common Stock"
 	SECURITYTYPE_CONTRACTFORDIFFERENCE      = "CONTRACT FOR DIFFERENCE"
 	SECURITYTYPE_CONTRACTFRA                = "CONTRACT FRA"
+	SECURITYTYPE_CPLIKEEXTNOTE              = "CP-LIKE EXT NOTE"
+	SECURITYTYPE_CPILINKED                  = "CPI LINKED"
+	SECURITYTYPE_CROSS                      = "CROSS"
+	SECURITYTYPE_CURVE_ROLL                 = "CURVE_ROLL"
+	SECURITYTYPE_CalendarSpreadOption       = "Calendar Spread Option"
+	SECURITYTYPE_Canadian                   = "Canadian"
+	SECURITYTYPE_CanadianDR                 = "Canadian DR"
+	SECURITYTYPE_CarForward                 = "Car Forward"
+	SECURITYTYPE_ClosedEndFund              = "Closed-End Fund"
+	SECURITYTYPE_CmdtFutWRT                 = "Cmdt Fut WRT"
+	SECURITYTYPE_CmdtIdxWRT                 = "Cmdt Idx WRT"
+	SECURITYTYPE_CommodityIndex             = "Commodity Index"
+	SECURITYTYPE_CommonStock                = "Common Stock"
 	SECURITYTYPE_ConvBond                   = "Conv Bond"
 	SECURITYTYPE_ConvPrfd                   = "Conv Prfd"
 	SECURITYTYPE_CorpBndWRT                 = "Corp Bnd WRT"
 	SECURITYTYPE_CoverPool                  = "Cover Pool"
-	SECURITYTYPE_CPLIKEEXTNOTE              = "CP-LIKE EXT NOTE"
-	SECURITYTYPE_CPILINKED                  = "CPI LINKED"
-	SECURITYTYPE_CROSS                      = "CROSS"
 	SECURITYTYPE_Crypto                     = "Crypto"
+	SECURITYTYPE_CurrencyWRT                = "Currency WRT"
 	SECURITYTYPE_Currencyfuture             = "Currency future."
 	SECURITYTYPE_Currencyoption             = "Currency option."
 	SECURITYTYPE_Currencyspot               = "Currency spot."
-	SECURITYTYPE_CurrencyWRT                = "Currency WRT"
-	SECURITYTYPE_CURVE_ROLL                 = "CURVE_ROLL"
 	SECURITYTYPE_DELAYDRAW                  = "DELAY-DRAW"
 	SECURITYTYPE_DELAYDRAWISLAMIC           = "DELAY-DRAW ISLAMIC"
 	SECURITYTYPE_DELAYDRAWISLAMICLOC        = "DELAY-DRAW ISLAMIC LOC"
@@ -157,12 +163,9 @@ const (
 	SECURITYTYPE_DOMESTCTIMEDEP             = "DOMESTC TIME DEP"
 	SECURITYTYPE_DOMESTIC                   = "DOMESTIC"
 	SECURITYTYPE_DOMESTICMTN                = "DOMESTIC MTN"
-	SECURITYTYPE_DutchCert                  = "Dutch Cert"
 	SECURITYTYPE_DUTCHCP                    = "DUTCH CP"
+	SECURITYTYPE_DutchCert                  = "Dutch Cert"
 	SECURITYTYPE_EDR                        = "EDR"
-	SECURITYTYPE_EquityIndex                = "Equity Index"
-	SECURITYTYPE_EquityOption               = "Equity Option"
-	SECURITYTYPE_EquityWRT                  = "Equity WRT"
 	SECURITYTYPE_ETP                        = "ETP"
 	SECURITYTYPE_EUROCD                     = "EURO CD"
 	SECURITYTYPE_EUROCP                     = "EURO CP"
@@ -174,46 +177,49 @@ const (
 	SECURITYTYPE_EUROZONE                   = "EURO-ZONE"
 	SECURITYTYPE_EXTENDCOMMNOTE             = "EXTEND COMM NOTE"
 	SECURITYTYPE_EXTENDNOTEMTN              = "EXTEND. NOTE MTN"
+	SECURITYTYPE_EquityIndex                = "Equity Index"
+	SECURITYTYPE_EquityOption               = "Equity Option"
+	SECURITYTYPE_EquityWRT                  = "Equity WRT"
 	SECURITYTYPE_FDIC                       = "FDIC"
 	SECURITYTYPE_FEDFUNDS                   = "FED FUNDS"
 	SECURITYTYPE_FIDC                       = "FIDC"
-	SECURITYTYPE_Financialcommodityfuture   = "Financial commodity future."
-	SECURITYTYPE_Financialcommoditygeneric  = "Financial commodity generic."
-	SECURITYTYPE_Financialcommodityoption   = "Financial commodity option."
-	SECURITYTYPE_Financialcommodityspot     = "Financial commodity spot."
-	SECURITYTYPE_Financialindexfuture       = "Financial index future."
-	SECURITYTYPE_Financialindexgeneric      = "Financial index generic."
-	SECURITYTYPE_Financialindexoption       = "Financial index option."
 	SECURITYTYPE_FINNISHCD                  = "FINNISH CD"
 	SECURITYTYPE_FINNISHCP                  = "FINNISH CP"
 	SECURITYTYPE_FIXED                      = "FIXED"
-	SECURITYTYPE_FixedIncomeIndex           = "Fixed Income Index"
 	SECURITYTYPE_FIXEDOID                   = "FIXED, OID"
 	SECURITYTYPE_FIXINGRATE                 = "FIXING RATE"
 	SECURITYTYPE_FLOATING                   = "FLOATING"
 	SECURITYTYPE_FLOATINGCP                 = "FLOATING CP"
 	SECURITYTYPE_FLOATINGOID                = "FLOATING, OID"
 	SECURITYTYPE_FNMAFHAVA                  = "FNMA FHAVA"
-	SECURITYTYPE_ForeignSh                  = "Foreign Sh."
 	SECURITYTYPE_FORWARD                    = "FORWARD"
 	SECURITYTYPE_FORWARDCROSS               = "FORWARD CROSS"
 	SECURITYTYPE_FORWARDCURVE               = "FORWARD CURVE"
 	SECURITYTYPE_FRA                        = "FRA"
 	SECURITYTYPE_FRENCHCD                   = "FRENCH CD"
-	SECURITYTYPE_FrenchCert                 = "French Cert"
 	SECURITYTYPE_FRENCHCP                   = "FRENCH CP"
-	SECURITYTYPE_FundofFunds                = "Fund of Funds"
-	SECURITYTYPE_FuturesMonthlyTicker       = "Futures Monthly Ticker"
 	SECURITYTYPE_FWDSWAP                    = "FWD SWAP"
 	SECURITYTYPE_FXCurve                    = "FX Curve"
 	SECURITYTYPE_FXDISCOUNTNOTE             = "FX DISCOUNT NOTE"
+	SECURITYTYPE_Financialcommodityfuture   = "Financial commodity future."
+	SECURITYTYPE_Financialcommoditygeneric  = "Financial commodity generic."
+	SECURITYTYPE_Financialcommodityoption   = "Financial commodity option."
+	SECURITYTYPE_Financialcommodityspot     = "Financial commodity spot."
+	SECURITYTYPE_Financialindexfuture       = "Financial index future."
+	SECURITYTYPE_Financialindexgeneric      = "Financial index generic."
+	SECURITYTYPE_Financialindexoption       = "Financial index option."
+	SECURITYTYPE_FixedIncomeIndex           = "Fixed Income Index"
+	SECURITYTYPE_ForeignSh                  = "Foreign Sh."
+	SECURITYTYPE_FrenchCert                 = "French Cert"
+	SECURITYTYPE_FundofFunds                = "Fund of Funds"
+	SECURITYTYPE_FuturesMonthlyTicker       = "Futures Monthly Ticker"
 	SECURITYTYPE_GDR                        = "GDR"
-	SECURITYTYPE_Genericcurrencyfuture      = "Generic currency future."
-	SECURITYTYPE_Genericindexfuture         = "Generic index future."
-	SECURITYTYPE_GermanCert                 = "German Cert"
 	SECURITYTYPE_GERMANCP                   = "GERMAN CP"
 	SECURITYTYPE_GLOBAL                     = "GLOBAL"
 	SECURITYTYPE_GUARANTEEFAC               = "GUARANTEE FAC"
+	SECURITYTYPE_Genericcurrencyfuture      = "Generic currency future."
+	SECURITYTYPE_Genericindexfuture         = "Generic index future."
+	SECURITYTYPE_GermanCert                 = "German Cert"
 	SECURITYTYPE_HB                         = "HB"
 	SECURITYTYPE_HDR                        = "HDR"
 	SECURITYTYPE_HONGKONGCD                 = "HONG KONG CD"
@@ -222,16 +228,11 @@ const (
 	SECURITYTYPE_IDR                        = "IDR"
 	SECURITYTYPE_IMMFORWARD                 = "IMM FORWARD"
 	SECURITYTYPE_IMMSWAP                    = "IMM SWAP"
-	SECURITYTYPE_Index                      = "Index"
-	SECURITYTYPE_IndexOption                = "Index Option"
-	SECURITYTYPE_IndexWRT                   = "Index WRT"
 	SECURITYTYPE_INDIANCD                   = "INDIAN CD"
 	SECURITYTYPE_INDIANCP                   = "INDIAN CP"
 	SECURITYTYPE_INDONESIANCP               = "INDONESIAN CP"
-	SECURITYTYPE_IndxFutWRT                 = "Indx Fut WRT"
 	SECURITYTYPE_INFLATIONSWAP              = "INFLATION SWAP"
 	SECURITYTYPE_INTBEARFIXBIS              = "INT BEAR FIXBIS"
-	SECURITYTYPE_IntRtWRT                   = "Int. Rt. WRT"
 	SECURITYTYPE_INTERAPPRECIATION          = "INTER. APPRECIATION"
 	SECURITYTYPE_INTERAPPRECIATIONOID       = "INTER. APPRECIATION, OID"
 	SECURITYTYPE_ISLAMIC                    = "ISLAMIC"
@@ -247,6 +248,11 @@ const (
 	SECURITYTYPE_ISLAMICTERMGUARANTEEFAC    = "ISLAMIC TERM GUARANTEE FAC"
 	SECURITYTYPE_ISLAMICTERMVATTRNCH        = "ISLAMIC TERM VAT-TRNCH"
 	SECURITYTYPE_ISLAMICVATTRNCH            = "ISLAMIC VAT-TRNCH"
+	SECURITYTYPE_Index                      = "Index"
+	SECURITYTYPE_IndexOption                = "Index Option"
+	SECURITYTYPE_IndexWRT                   = "Index WRT"
+	SECURITYTYPE_IndxFutWRT                 = "Indx Fut WRT"
+	SECURITYTYPE_IntRtWRT                   = "Int. Rt. WRT"
 	SECURITYTYPE_JUMBOCD                    = "JUMBO CD"
 	SECURITYTYPE_KOREANCD                   = "KOREAN CD"
 	SECURITYTYPE_KOREANCP                   = "KOREAN CP"
@@ -257,7 +263,6 @@ const (
 	SECURITYTYPE_LOCTERM                    = "LOC TERM"
 	SECURITYTYPE_LtdPart                    = "Ltd Part"
 	SECURITYTYPE_MALAYSIANCP                = "MALAYSIAN CP"
-	SECURITYTYPE_ManagedAccount             = "Managed Account"
 	SECURITYTYPE_MARGINTERMDEP              = "MARGIN TERM DEP"
 	SECURITYTYPE_MASTERNOTES                = "MASTER NOTES"
 	SECURITYTYPE_MBS10yr                    = "MBS 10yr"
@@ -270,14 +275,13 @@ const (
 	SECURITYTYPE_MBS5yr                     = "MBS 5yr"
 	SECURITYTYPE_MBS7yr                     = "MBS 7yr"
 	SECURITYTYPE_MBSARM                     = "MBS ARM"
-	SECURITYTYPE_MBSballoon                 = "MBS balloon"
 	SECURITYTYPE_MBSOther                   = "MBS Other"
+	SECURITYTYPE_MBSballoon                 = "MBS balloon"
 	SECURITYTYPE_MEDTERMNOTE                = "MED TERM NOTE"
 	SECURITYTYPE_MEDIUMTERMCD               = "MEDIUM TERM CD"
 	SECURITYTYPE_MEDIUMTERMECD              = "MEDIUM TERM ECD"
 	SECURITYTYPE_MEXICANCP                  = "MEXICAN CP"
 	SECURITYTYPE_MEXICANPAGARE              = "MEXICAN PAGARE"
-	SECURITYTYPE_Misc                       = "Misc."
 	SECURITYTYPE_MLP                        = "MLP"
 	SECURITYTYPE_MONETARYBILLS              = "MONETARY BILLS"
 	SECURITYTYPE_MONEYMARKETCALL            = "MONEY MARKET CALL"
@@ -285,9 +289,11 @@ const (
 	SECURITYTYPE_MUNIINTBEARCP              = "MUNI INT BEAR CP"
 	SECURITYTYPE_MUNISWAP                   = "MUNI SWAP"
 	SECURITYTYPE_MURABAHA                   = "MURABAHA"
-	SECURITYTYPE_MutualFund                 = "Mutual Fund"
 	SECURITYTYPE_MV                         = "MV"
 	SECURITYTYPE_MXCERTBURSATIL             = "MX CERT BURSATIL"
+	SECURITYTYPE_ManagedAccount             = "Managed Account"
+	SECURITYTYPE_Misc                       = "Misc."
+	SECURITYTYPE_MutualFund                 = "Mutual Fund"
 	SECURITYTYPE_NDFSWAP                    = "NDF SWAP"
 	SECURITYTYPE_NEGEUROCP                  = "NEG EURO CP"
 	SECURITYTYPE_NEGINSTDEPOSIT             = "NEG INST DEPOSIT"
@@ -301,24 +307,16 @@ const (
 	SECURITYTYPE_OID                        = "OID"
 	SECURITYTYPE_ONSHOREFORWARD             = "ONSHORE FORWARD"
 	SECURITYTYPE_ONSHORESWAP                = "ONSHORE SWAP"
-	SECURITYTYPE_OpenEndFund                = "Open-End Fund"
 	SECURITYTYPE_OPTION                     = "OPTION"
-	SECURITYTYPE_OptiononEquityFuture       = "Option on Equity Future"
 	SECURITYTYPE_OPTIONVOLATILITY           = "OPTION VOLATILITY"
 	SECURITYTYPE_OTHER                      = "OTHER"
 	SECURITYTYPE_OVERNIGHT                  = "OVER/NIGHT"
 	SECURITYTYPE_OVERDRAFT                  = "OVERDRAFT"
 	SECURITYTYPE_OVERNIGHTINDEXEDSWAP       = "OVERNIGHT INDEXED SWAP"
+	SECURITYTYPE_OpenEndFund                = "Open-End Fund"
+	SECURITYTYPE_OptiononEquityFuture       = "Option on Equity Future"
 	SECURITYTYPE_PANAMANIANCP               = "PANAMANIAN CP"
-	SECURITYTYPE_ParticipateCert            = "Participate Cert"
 	SECURITYTYPE_PHILIPPINECP               = "PHILIPPINE CP"
-	SECURITYTYPE_Physicalcommodityforward   = "Physical commodity forward."
-	SECURITYTYPE_Physicalcommodityfuture    = "Physical commodity future."
-	SECURITYTYPE_Physicalcommoditygeneric   = "Physical commodity generic."
-	SECURITYTYPE_Physicalcommodityoption    = "Physical commodity option."
-	SECURITYTYPE_Physicalcommodityspot      = "Physical commodity spot."
-	SECURITYTYPE_Physicalindexfuture        = "Physical index future."
-	SECURITYTYPE_Physicalindexoption        = "Physical index option."
 	SECURITYTYPE_PIK                        = "PIK"
 	SECURITYTYPE_PIKLOC                     = "PIK LOC"
 	SECURITYTYPE_PIKREV                     = "PIK REV"
@@ -326,16 +324,25 @@ const (
 	SECURITYTYPE_PIKTERM                    = "PIK TERM"
 	SECURITYTYPE_PLAZOSFIJOS                = "PLAZOS FIJOS"
 	SECURITYTYPE_PORTUGUESECP               = "PORTUGUESE CP"
-	SECURITYTYPE_Preference                 = "Preference"
-	SECURITYTYPE_Preferred                  = "Preferred"
 	SECURITYTYPE_PRES                       = "PRES"
-	SECURITYTYPE_PrfdWRT                    = "Prfd WRT"
 	SECURITYTYPE_PRIVPLACEMENT              = "PRIV PLACEMENT"
 	SECURITYTYPE_PRIVATE                    = "PRIVATE"
-	SECURITYTYPE_PrivateComp                = "Private Comp"
-	SECURITYTYPE_Privateequitybacked        = "Private-equity backed"
 	SECURITYTYPE_PROMISSORYNOTE             = "PROMISSORY NOTE"
 	SECURITYTYPE_PROVTBILL                  = "PROV T-BILL"
+	SECURITYTYPE_PUBLIC                     = "PUBLIC"
+	SECURITYTYPE_ParticipateCert            = "Participate Cert"
+	SECURITYTYPE_Physicalcommodityforward   = "Physical commodity forward."
+	SECURITYTYPE_Physicalcommodityfuture    = "Physical commodity future."
+	SECURITYTYPE_Physicalcommoditygeneric   = "Physical commodity generic."
+	SECURITYTYPE_Physicalcommodityoption    = "Physical commodity option."
+	SECURITYTYPE_Physicalcommodityspot      = "Physical commodity spot."
+	SECURITYTYPE_Physicalindexfuture        = "Physical index future."
+	SECURITYTYPE_Physicalindexoption        = "Physical index option."
+	SECURITYTYPE_Preference                 = "Preference"
+	SECURITYTYPE_Preferred                  = "Preferred"
+	SECURITYTYPE_PrfdWRT                    = "Prfd WRT"
+	SECURITYTYPE_PrivateComp                = "Private Comp"
+	SECURITYTYPE_Privateequitybacked        = "Private-equity backed"
 	SECURITYTYPE_PrvtCMBS                   = "Prvt CMBS"
 	SECURITYTYPE_PrvtCMOFLT                 = "Prvt CMO FLT"
 	SECURITYTYPE_PrvtCMOINV                 = "Prvt CMO INV"
@@ -343,10 +350,8 @@ const (
 	SECURITYTYPE_PrvtCMOOther               = "Prvt CMO Other"
 	SECURITYTYPE_PrvtCMOPO                  = "Prvt CMO PO"
 	SECURITYTYPE_PrvtCMOZ                   = "Prvt CMO Z"
-	SECURITYTYPE_PUBLIC                     = "PUBLIC"
 	SECURITYTYPE_PvtEqtyFund                = "Pvt Eqty Fund"
 	SECURITYTYPE_RDC                        = "RDC"
-	SECURITYTYPE_Receipt                    = "Receipt"
 	SECURITYTYPE_REIT                       = "REIT"
 	SECURITYTYPE_REPO                       = "REPO"
 	SECURITYTYPE_RESERVEBASEDDIPREV         = "RESERVE-BASED DIP REV"
@@ -358,22 +363,19 @@ const (
 	SECURITYTYPE_REV                        = "REV"
 	SECURITYTYPE_REVGUARANTEEFAC            = "REV GUARANTEE FAC"
 	SECURITYTYPE_REVVATTRNCH                = "REV VAT-TRNCH"
+	SECURITYTYPE_Receipt                    = "Receipt"
 	SECURITYTYPE_Revolver                   = "Revolver"
 	SECURITYTYPE_Right                      = "Right"
 	SECURITYTYPE_RoyaltyTrst                = "Royalty Trst"
 	SECURITYTYPE_STERMLOANNOTE              = "S.TERM LOAN NOTE"
 	SECURITYTYPE_SAMURAI                    = "SAMURAI"
-	SECURITYTYPE_SavingsPlan                = "Savings Plan"
-	SECURITYTYPE_SavingsShare               = "Savings Share"
 	SECURITYTYPE_SBAPool                    = "SBA Pool"
 	SECURITYTYPE_SDR                        = "SDR"
 	SECURITYTYPE_SECGENCOLLNOT              = "SEC GEN COLL NOT"
-	SECURITYTYPE_SecLending                 = "Sec Lending"
 	SECURITYTYPE_SHOGUN                     = "SHOGUN"
 	SECURITYTYPE_SHORTTERMBN                = "SHORT TERM BN"
 	SECURITYTYPE_SHORTTERMDN                = "SHORT TERM DN"
 	SECURITYTYPE_SINGAPORECP                = "SINGAPORE CP"
-	SECURITYTYPE_SingaporeDR                = "Singapore DR"
 	SECURITYTYPE_SINGLESTOCKDIVIDENDFUTURE  = "SINGLE STOCK DIVIDEND FUTURE"
 	SECURITYTYPE_SINGLESTOCKFORWARD         = "SINGLE STOCK FORWARD"
 	SECURITYTYPE_SINGLESTOCKFUTURE          = "SINGLE STOCK FUTURE"
@@ -382,25 +384,29 @@ const (
 	SECURITYTYPE_SPANISHCP                  = "SPANISH CP"
 	SECURITYTYPE_SPECIALLMMKPGM             = "SPECIAL LMMK PGM"
 	SECURITYTYPE_SPOT                       = "SPOT"
-	SECURITYTYPE_Spotindex                  = "Spot index."
 	SECURITYTYPE_STANDBY                    = "STANDBY"
 	SECURITYTYPE_STANDBYLOC                 = "STANDBY LOC"
 	SECURITYTYPE_STANDBYLOCGUARANTEEFAC     = "STANDBY LOC GUARANTEE FAC"
 	SECURITYTYPE_STANDBYREV                 = "STANDBY REV"
 	SECURITYTYPE_STANDBYTERM                = "STANDBY TERM"
-	SECURITYTYPE_StapledSecurity            = "Stapled Security"
 	SECURITYTYPE_STERLINGCD                 = "STERLING CD"
 	SECURITYTYPE_STERLINGCP                 = "STERLING CP"
-	SECURITYTYPE_StrategyTrade              = "Strategy Trade."
 	SECURITYTYPE_SWAP                       = "SWAP"
 	SECURITYTYPE_SWAPSPREAD                 = "SWAP SPREAD"
 	SECURITYTYPE_SWAPTIONVOLATILITY         = "SWAPTION VOLATILITY"
 	SECURITYTYPE_SWEDISHCP                  = "SWEDISH CP"
 	SECURITYTYPE_SWINGLINE                  = "SWINGLINE"
-	SECURITYTYPE_SwissCert                  = "Swiss Cert"
 	SECURITYTYPE_SYNTHLOC                   = "SYNTH LOC"
 	SECURITYTYPE_SYNTHREV                   = "SYNTH REV"
 	SECURITYTYPE_SYNTHTERM                  = "SYNTH TERM"
+	SECURITYTYPE_SavingsPlan                = "Savings Plan"
+	SECURITYTYPE_SavingsShare               = "Savings Share"
+	SECURITYTYPE_SecLending                 = "Sec Lending"
+	SECURITYTYPE_SingaporeDR                = "Singapore DR"
+	SECURITYTYPE_Spotindex                  = "Spot index."
+	SECURITYTYPE_StapledSecurity            = "Stapled Security"
+	SECURITYTYPE_StrategyTrade              = "Strategy Trade."
+	SECURITYTYPE_SwissCert                  = "Swiss Cert"
 	SECURITYTYPE_SyntheticTerm              = "Synthetic Term"
 	SECURITYTYPE_TAIWANCP                   = "TAIWAN CP"
 	SECURITYTYPE_TAIWANCPGUAR               = "TAIWAN CP GUAR"
@@ -410,23 +416,21 @@ const (
 	SECURITYTYPE_TAXCREDITOID               = "TAX CREDIT, OID"
 	SECURITYTYPE_TDR                        = "TDR"
 	SECURITYTYPE_TERM                       = "TERM"
-	SECURITYTYPE_Term                       = "Term"
 	SECURITYTYPE_TERMDEPOSITS               = "TERM DEPOSITS"
 	SECURITYTYPE_TERMGUARANTEEFAC           = "TERM GUARANTEE FAC"
 	SECURITYTYPE_TERMREV                    = "TERM REV"
 	SECURITYTYPE_TERMVATTRNCH               = "TERM VAT-TRNCH"
 	SECURITYTYPE_THAILANDCP                 = "THAILAND CP"
 	SECURITYTYPE_TLTROTERM                  = "TLTRO TERM"
-	SECURITYTYPE_TrackingStk                = "Tracking Stk"
 	SECURITYTYPE_TREASURYBILL               = "TREASURY BILL"
+	SECURITYTYPE_Term                       = "Term"
+	SECURITYTYPE_TrackingStk                = "Tracking Stk"
 	SECURITYTYPE_USCD                       = "U.S. CD"
 	SECURITYTYPE_USCP                       = "U.S. CP"
 	SECURITYTYPE_USINTBEARCP                = "U.S. INT BEAR CP"
 	SECURITYTYPE_UIT                        = "UIT"
 	SECURITYTYPE_UKGILTSTOCK                = "UK GILT STOCK"
 	SECURITYTYPE_UMBSMBSOther               = "UMBS MBS Other"
-	SECURITYTYPE_Unit                       = "Unit"
-	SECURITYTYPE_UnitInvTst                 = "Unit Inv Tst"
 	SECURITYTYPE_UNITRANCHE                 = "UNITRANCHE"
 	SECURITYTYPE_UNITRANCHEASSETBASEDREV    = "UNITRANCHE ASSET-BASED REV"
 	SECURITYTYPE_UNITRANCHEDELAYDRAWPIKT    = "UNITRANCHE DELAY-DRAW PIK T"
@@ -437,6 +441,8 @@ const (
 	SECURITYTYPE_USDOMESTIC                 = "US DOMESTIC"
 	SECURITYTYPE_USGOVERNMENT               = "US GOVERNMENT"
 	SECURITYTYPE_USNONDOLLAR                = "US NON-DOLLAR"
+	SECURITYTYPE_Unit                       = "Unit"
+	SECURITYTYPE_UnitInvTst                 = "Unit Inv Tst"
 	SECURITYTYPE_VARRATEDEMOBL              = "VAR RATE DEM OBL"
 	SECURITYTYPE_VATTRNCH                   = "VAT-TRNCH"
 	SECURITYTYPE_VENEZUELANCP               = "VENEZUELAN CP"
@@ -451,3 +457,468 @@ const (
 	SECURITYTYPE_ZEROCOUPON                 = "ZERO COUPON"
 	SECURITYTYPE_ZEROCOUPONOID              = "ZERO COUPON, OID"
 )
+
+var securityTypeValues = []string{
+	SECURITYTYPE_ABSAuto,
+	SECURITYTYPE_ABSCard,
+	SECURITYTYPE_ABSHome,
+	SECURITYTYPE_ABSOther,
+	SECURITYTYPE_ACCEPTBANCARIA,
+	SECURITYTYPE_ADJCONVTOFIXED,
+	SECURITYTYPE_ADJCONVTOFIXEDOID,
+	SECURITYTYPE_ADJUSTABLE,
+	SECURITYTYPE_ADJUSTABLEOID,
+	SECURITYTYPE_ADR,
+	SECURITYTYPE_ASSETBASED,
+	SECURITYTYPE_ASSETBASEDBRIDGE,
+	SECURITYTYPE_ASSETBASEDBRIDGEREV,
+	SECURITYTYPE_ASSETBASEDBRIDGETERM,
+	SECURITYTYPE_ASSETBASEDDELAYDRAWTERM,
+	SECURITYTYPE_ASSETBASEDDIP,
+	SECURITYTYPE_ASSETBASEDDIPDELAYDRAW,
+	SECURITYTYPE_ASSETBASEDDIPREV,
+	SECURITYTYPE_ASSETBASEDDIPTERM,
+	SECURITYTYPE_ASSETBASEDLOC,
+	SECURITYTYPE_ASSETBASEDPIKTERM,
+	SECURITYTYPE_ASSETBASEDREV,
+	SECURITYTYPE_ASSETBASEDTERM,
+	SECURITYTYPE_AUSTRALIAN,
+	SECURITYTYPE_AUSTRALIANCD,
+	SECURITYTYPE_AUSTRALIANCP,
+	SECURITYTYPE_AgncyABSHome,
+	SECURITYTYPE_AgncyABSOther,
+	SECURITYTYPE_AgncyCMBS,
+	SECURITYTYPE_AgncyCMOFLT,
+	SECURITYTYPE_AgncyCMOINV,
+	SECURITYTYPE_AgncyCMOIO,
+	SECURITYTYPE_AgncyCMOOther,
+	SECURITYTYPE_AgncyCMOPO,
+	SECURITYTYPE_AgncyCMOZ,
+	SECURITYTYPE_AssetBased,
+	SECURITYTYPE_AustrianCrt,
+	SECURITYTYPE_BANKACCEPTBILL,
+	SECURITYTYPE_BANKBILL,
+	SECURITYTYPE_BANKNOTE,
+	SECURITYTYPE_BANKERSACCEPT,
+	SECURITYTYPE_BANKERSACCEPTANCE,
+	SECURITYTYPE_BASISSWAP,
+	SECURITYTYPE_BASISTRADEONCLOSE,
+	SECURITYTYPE_BDR,
+	SECURITYTYPE_BEARERDEPNOTE,
+	SECURITYTYPE_BELGIUMCP,
+	SECURITYTYPE_BILLOFEXCHANGE,
+	SECURITYTYPE_BILLETAORDRE,
+	SECURITYTYPE_BRAZILGENERIC,
+	SECURITYTYPE_BRAZILIANCDI,
+	SECURITYTYPE_BRIDGE,
+	SECURITYTYPE_BRIDGEDELAYDRAW,
+	SECURITYTYPE_BRIDGEDELAYDRAWTERM,
+	SECURITYTYPE_BRIDGEDIPTERM,
+	SECURITYTYPE_BRIDGEGUARANTEEFAC,
+	SECURITYTYPE_BRIDGEISLAMIC,
+	SECURITYTYPE_BRIDGEISLAMICTERM,
+	SECURITYTYPE_BRIDGEPIK,
+	SECURITYTYPE_BRIDGEPIKREV,
+	SECURITYTYPE_BRIDGEPIKTERM,
+	SECURITYTYPE_BRIDGEREV,
+	SECURITYTYPE_BRIDGEREVGUARANTEEFAC,
+	SECURITYTYPE_BRIDGESTANDBYTERM,
+	SECURITYTYPE_BRIDGETERM,
+	SECURITYTYPE_BRIDGETERMGUARANTEEFAC,
+	SECURITYTYPE_BRIDGETERMVATTRNCH,
+	SECURITYTYPE_BRIDGEVATTRNCH,
+	SECURITYTYPE_BULLDOG,
+	SECURITYTYPE_BUTTERFLYSWAP,
+	SECURITYTYPE_BasketWRT,
+	SECURITYTYPE_BelgiumCert,
+	SECURITYTYPE_Bond,
+	SECURITYTYPE_CADINTBEARCP,
+	SECURITYTYPE_CALC_INSTRUMENT,
+	SECURITYTYPE_CALLLOANS,
+	SECURITYTYPE_CALLABLECP,
+	SECURITYTYPE_CANADIAN,
+	SECURITYTYPE_CANADIANCD,
+	SECURITYTYPE_CANADIANCP,
+	SECURITYTYPE_CAPSFLOORS,
+	SECURITYTYPE_CASH,
+	SECURITYTYPE_CASHFLOW,
+	SECURITYTYPE_CASHFLOWOID,
+	SECURITYTYPE_CASHRATE,
+	SECURITYTYPE_CBLO,
+	SECURITYTYPE_CD,
+	SECURITYTYPE_CDI,
+	SECURITYTYPE_CDR,
+	SECURITYTYPE_CEDEAR,
+	SECURITYTYPE_CF,
+	SECURITYTYPE_CHILEANCD,
+	SECURITYTYPE_CHILEANDN,
+	SECURITYTYPE_CMBS,
+	SECURITYTYPE_COLLATCALLNOTE,
+	SECURITYTYPE_COLOMBIANCD,
+	SECURITYTYPE_COMMERCIALNOTE,
+	SECURITYTYPE_COMMERCIALPAPER,
+	SECURITYTYPE_CONTRACTFORDIFFERENCE,
+	SECURITYTYPE_CONTRACTFRA,
+	SECURITYTYPE_CPLIKEEXTNOTE,
+	SECURITYTYPE_CPILINKED,
+	SECURITYTYPE_CROSS,
+	SECURITYTYPE_CURVE_ROLL,
+	SECURITYTYPE_CalendarSpreadOption,
+	SECURITYTYPE_Canadian,
+	SECURITYTYPE_CanadianDR,
+	SECURITYTYPE_CarForward,
+	SECURITYTYPE_ClosedEndFund,
+	SECURITYTYPE_CmdtFutWRT,
+	SECURITYTYPE_CmdtIdxWRT,
+	SECURITYTYPE_CommodityIndex,
+	SECURITYTYPE_CommonStock,
+	SECURITYTYPE_ConvBond,
+	SECURITYTYPE_ConvPrfd,
+	SECURITYTYPE_CorpBndWRT,
+	SECURITYTYPE_CoverPool,
+	SECURITYTYPE_Crypto,
+	SECURITYTYPE_CurrencyWRT,
+	SECURITYTYPE_Currencyfuture,
+	SECURITYTYPE_Currencyoption,
+	SECURITYTYPE_Currencyspot,
+	SECURITYTYPE_DELAYDRAW,
+	SECURITYTYPE_DELAYDRAWISLAMIC,
+	SECURITYTYPE_DELAYDRAWISLAMICLOC,
+	SECURITYTYPE_DELAYDRAWISLAMICTERM,
+	SECURITYTYPE_DELAYDRAWLOC,
+	SECURITYTYPE_DELAYDRAWPIKTERM,
+	SECURITYTYPE_DELAYDRAWSTANDBYTERM,
+	SECURITYTYPE_DELAYDRAWTERM,
+	SECURITYTYPE_DELAYDRAWTERMGUARANTEEF,
+	SECURITYTYPE_DELAYDRAWTERMVATTRNCH,
+	SECURITYTYPE_DEPOSIT,
+	SECURITYTYPE_DEPOSITNOTE,
+	SECURITYTYPE_DIMSUMBRIDGETERM,
+	SECURITYTYPE_DIMSUMDELAYDRAWTERM,
+	SECURITYTYPE_DIMSUMREV,
+	SECURITYTYPE_DIMSUMTERM,
+	SECURITYTYPE_DIP,
+	SECURITYTYPE_DIPDELAYDRAWISLAMICTERM,
+	SECURITYTYPE_DIPDELAYDRAWPIKTERM,
+	SECURITYTYPE_DIPDELAYDRAWTERM,
+	SECURITYTYPE_DIPLOC,
+	SECURITYTYPE_DIPPIKTERM,
+	SECURITYTYPE_DIPREV,
+	SECURITYTYPE_DIPSTANDBYLOC,
+	SECURITYTYPE_DIPSYNTHLOC,
+	SECURITYTYPE_DIPTERM,
+	SECURITYTYPE_DISCOUNTFIXBIS,
+	SECURITYTYPE_DISCOUNTNOTES,
+	SECURITYTYPE_DIVIDENDNEUTRALSTOCKFUTURE,
+	SECURITYTYPE_DOMESTCTIMEDEP,
+	SECURITYTYPE_DOMESTIC,
+	SECURITYTYPE_DOMESTICMTN,
+	SECURITYTYPE_DUTCHCP,
+	SECURITYTYPE_DutchCert,
+	SECURITYTYPE_EDR,
+	SECURITYTYPE_ETP,
+	SECURITYTYPE_EUROCD,
+	SECURITYTYPE_EUROCP,
+	SECURITYTYPE_EUROMTN,
+	SECURITYTYPE_EURONONDOLLAR,
+	SECURITYTYPE_EUROSTRUCTRDLN,
+	SECURITYTYPE_EUROTIMEDEPST,
+	SECURITYTYPE_EURODOLLAR,
+	SECURITYTYPE_EUROZONE,
+	SECURITYTYPE_EXTENDCOMMNOTE,
+	SECURITYTYPE_EXTENDNOTEMTN,
+	SECURITYTYPE_EquityIndex,
+	SECURITYTYPE_EquityOption,
+	SECURITYTYPE_EquityWRT,
+	SECURITYTYPE_FDIC,
+	SECURITYTYPE_FEDFUNDS,
+	SECURITYTYPE_FIDC,
+	SECURITYTYPE_FINNISHCD,
+	SECURITYTYPE_FINNISHCP,
+	SECURITYTYPE_FIXED,
+	SECURITYTYPE_FIXEDOID,
+	SECURITYTYPE_FIXINGRATE,
+	SECURITYTYPE_FLOATING,
+	SECURITYTYPE_FLOATINGCP,
+	SECURITYTYPE_FLOATINGOID,
+	SECURITYTYPE_FNMAFHAVA,
+	SECURITYTYPE_FORWARD,
+	SECURITYTYPE_FORWARDCROSS,
+	SECURITYTYPE_FORWARDCURVE,
+	SECURITYTYPE_FRA,
+	SECURITYTYPE_FRENCHCD,
+	SECURITYTYPE_FRENCHCP,
+	SECURITYTYPE_FWDSWAP,
+	SECURITYTYPE_FXCurve,
+	SECURITYTYPE_FXDISCOUNTNOTE,
+	SECURITYTYPE_Financialcommodityfuture,
+	SECURITYTYPE_Financialcommoditygeneric,
+	SECURITYTYPE_Financialcommodityoption,
+	SECURITYTYPE_Financialcommodityspot,
+	SECURITYTYPE_Financialindexfuture,
+	SECURITYTYPE_Financialindexgeneric,
+	SECURITYTYPE_Financialindexoption,
+	SECURITYTYPE_FixedIncomeIndex,
+	SECURITYTYPE_ForeignSh,
+	SECURITYTYPE_FrenchCert,
+	SECURITYTYPE_FundofFunds,
+	SECURITYTYPE_FuturesMonthlyTicker,
+	SECURITYTYPE_GDR,
+	SECURITYTYPE_GERMANCP,
+	SECURITYTYPE_GLOBAL,
+	SECURITYTYPE_GUARANTEEFAC,
+	SECURITYTYPE_Genericcurrencyfuture,
+	SECURITYTYPE_Genericindexfuture,
+	SECURITYTYPE_GermanCert,
+	SECURITYTYPE_HB,
+	SECURITYTYPE_HDR,
+	SECURITYTYPE_HONGKONGCD,
+	SECURITYTYPE_IRFutWRT,
+	SECURITYTYPE_IRSwpWRT,
+	SECURITYTYPE_IDR,
+	SECURITYTYPE_IMMFORWARD,
+	SECURITYTYPE_IMMSWAP,
+	SECURITYTYPE_INDIANCD,
+	SECURITYTYPE_INDIANCP,
+	SECURITYTYPE_INDONESIANCP,
+	SECURITYTYPE_INFLATIONSWAP,
+	SECURITYTYPE_INTBEARFIXBIS,
+	SECURITYTYPE_INTERAPPRECIATION,
+	SECURITYTYPE_INTERAPPRECIATIONOID,
+	SECURITYTYPE_ISLAMIC,
+	SECURITYTYPE_ISLAMICBA,
+	SECURITYTYPE_ISLAMICCP,
+	SECURITYTYPE_ISLAMICGUARANTEEFAC,
+	SECURITYTYPE_ISLAMICLOC,
+	SECURITYTYPE_ISLAMICREV,
+	SECURITYTYPE_ISLAMICSTANDBY,
+	SECURITYTYPE_ISLAMICSTANDBYREV,
+	SECURITYTYPE_ISLAMICSTANDBYTERM,
+	SECURITYTYPE_ISLAMICTERM,
+	SECURITYTYPE_ISLAMICTERMGUARANTEEFAC,
+	SECURITYTYPE_ISLAMICTERMVATTRNCH,
+	SECURITYTYPE_ISLAMICVATTRNCH,
+	SECURITYTYPE_Index,
+	SECURITYTYPE_IndexOption,
+	SECURITYTYPE_IndexWRT,
+	SECURITYTYPE_IndxFutWRT,
+	SECURITYTYPE_IntRtWRT,
+	SECURITYTYPE_JUMBOCD,
+	SECURITYTYPE_KOREANCD,
+	SECURITYTYPE_KOREANCP,
+	SECURITYTYPE_LEBANESECP,
+	SECURITYTYPE_LIQUIDITYNOTE,
+	SECURITYTYPE_LOC,
+	SECURITYTYPE_LOCGUARANTEEFAC,
+	SECURITYTYPE_LOCTERM,
+	SECURITYTYPE_LtdPart,
+	SECURITYTYPE_MALAYSIANCP,
+	SECURITYTYPE_MARGINTERMDEP,
+	SECURITYTYPE_MASTERNOTES,
+	SECURITYTYPE_MBS10yr,
+	SECURITYTYPE_MBS15yr,
+	SECURITYTYPE_MBS20yr,
+	SECURITYTYPE_MBS30yr,
+	SECURITYTYPE_MBS35yr,
+	SECURITYTYPE_MBS40yr,
+	SECURITYTYPE_MBS50yr,
+	SECURITYTYPE_MBS5yr,
+	SECURITYTYPE_MBS7yr,
+	SECURITYTYPE_MBSARM,
+	SECURITYTYPE_MBSOther,
+	SECURITYTYPE_MBSballoon,
+	SECURITYTYPE_MEDTERMNOTE,
+	SECURITYTYPE_MEDIUMTERMCD,
+	SECURITYTYPE_MEDIUMTERMECD,
+	SECURITYTYPE_MEXICANCP,
+	SECURITYTYPE_MEXICANPAGARE,
+	SECURITYTYPE_MLP,
+	SECURITYTYPE_MONETARYBILLS,
+	SECURITYTYPE_MONEYMARKETCALL,
+	SECURITYTYPE_MUNICP,
+	SECURITYTYPE_MUNIINTBEARCP,
+	SECURITYTYPE_MUNISWAP,
+	SECURITYTYPE_MURABAHA,
+	SECURITYTYPE_MV,
+	SECURITYTYPE_MXCERTBURSATIL,
+	SECURITYTYPE_ManagedAccount,
+	SECURITYTYPE_Misc,
+	SECURITYTYPE_MutualFund,
+	SECURITYTYPE_NDFSWAP,
+	SECURITYTYPE_NEGEUROCP,
+	SECURITYTYPE_NEGINSTDEPOSIT,
+	SECURITYTYPE_NEGOTIABLECD,
+	SECURITYTYPE_NEWZEALANDCD,
+	SECURITYTYPE_NEWZEALANDCP,
+	SECURITYTYPE_NONDELIVERABLEFORWARD,
+	SECURITYTYPE_NONDELIVERABLEIRSSWAP,
+	SECURITYTYPE_NVDR,
+	SECURITYTYPE_NYRegShrs,
+	SECURITYTYPE_OID,
+	SECURITYTYPE_ONSHOREFORWARD,
+	SECURITYTYPE_ONSHORESWAP,
+	SECURITYTYPE_OPTION,
+	SECURITYTYPE_OPTIONVOLATILITY,
+	SECURITYTYPE_OTHER,
+	SECURITYTYPE_OVERNIGHT,
+	SECURITYTYPE_OVERDRAFT,
+	SECURITYTYPE_OVERNIGHTINDEXEDSWAP,
+	SECURITYTYPE_OpenEndFund,
+	SECURITYTYPE_OptiononEquityFuture,
+	SECURITYTYPE_PANAMANIANCP,
+	SECURITYTYPE_PHILIPPINECP,
+	SECURITYTYPE_PIK,
+	SECURITYTYPE_PIKLOC,
+	SECURITYTYPE_PIKREV,
+	SECURITYTYPE_PIKSYNTHLOC,
+	SECURITYTYPE_PIKTERM,
+	SECURITYTYPE_PLAZOSFIJOS,
+	SECURITYTYPE_PORTUGUESECP,
+	SECURITYTYPE_PRES,
+	SECURITYTYPE_PRIVPLACEMENT,
+	SECURITYTYPE_PRIVATE,
+	SECURITYTYPE_PROMISSORYNOTE,
+	SECURITYTYPE_PROVTBILL,
+	SECURITYTYPE_PUBLIC,
+	SECURITYTYPE_ParticipateCert,
+	SECURITYTYPE_Physicalcommodityforward,
+	SECURITYTYPE_Physicalcommodityfuture,
+	SECURITYTYPE_Physicalcommoditygeneric,
+	SECURITYTYPE_Physicalcommodityoption,
+	SECURITYTYPE_Physicalcommodityspot,
+	SECURITYTYPE_Physicalindexfuture,
+	SECURITYTYPE_Physicalindexoption,
+	SECURITYTYPE_Preference,
+	SECURITYTYPE_Preferred,
+	SECURITYTYPE_PrfdWRT,
+	SECURITYTYPE_PrivateComp,
+	SECURITYTYPE_Privateequitybacked,
+	SECURITYTYPE_PrvtCMBS,
+	SECURITYTYPE_PrvtCMOFLT,
+	SECURITYTYPE_PrvtCMOINV,
+	SECURITYTYPE_PrvtCMOIO,
+	SECURITYTYPE_PrvtCMOOther,
+	SECURITYTYPE_PrvtCMOPO,
+	SECURITYTYPE_PrvtCMOZ,
+	SECURITYTYPE_PvtEqtyFund,
+	SECURITYTYPE_RDC,
+	SECURITYTYPE_REIT,
+	SECURITYTYPE_REPO,
+	SECURITYTYPE_RESERVEBASEDDIPREV,
+	SECURITYTYPE_RESERVEBASEDREV,
+	SECURITYTYPE_RESERVEBASEDTERM,
+	SECURITYTYPE_RESTRUCTURDDEBT,
+	SECURITYTYPE_RETAILCD,
+	SECURITYTYPE_RETURNIDX,
+	SECURITYTYPE_REV,
+	SECURITYTYPE_REVGUARANTEEFAC,
+	SECURITYTYPE_REVVATTRNCH,
+	SECURITYTYPE_Receipt,
+	SECURITYTYPE_Revolver,
+	SECURITYTYPE_Right,
+	SECURITYTYPE_RoyaltyTrst,
+	SECURITYTYPE_STERMLOANNOTE,
+	SECURITYTYPE_SAMURAI,
+	SECURITYTYPE_SBAPool,
+	SECURITYTYPE_SDR,
+	SECURITYTYPE_SECGENCOLLNOT,
+	SECURITYTYPE_SHOGUN,
+	SECURITYTYPE_SHORTTERMBN,
+	SECURITYTYPE_SHORTTERMDN,
+	SECURITYTYPE_SINGAPORECP,
+	SECURITYTYPE_SINGLESTOCKDIVIDENDFUTURE,
+	SECURITYTYPE_SINGLESTOCKFORWARD,
+	SECURITYTYPE_SINGLESTOCKFUTURE,
+	SECURITYTYPE_SINGLESTOCKFUTURESPREAD,
+	SECURITYTYPE_SN,
+	SECURITYTYPE_SPANISHCP,
+	SECURITYTYPE_SPECIALLMMKPGM,
+	SECURITYTYPE_SPOT,
+	SECURITYTYPE_STANDBY,
+	SECURITYTYPE_STANDBYLOC,
+	SECURITYTYPE_STANDBYLOCGUARANTEEFAC,
+	SECURITYTYPE_STANDBYREV,
+	SECURITYTYPE_STANDBYTERM,
+	SECURITYTYPE_STERLINGCD,
+	SECURITYTYPE_STERLINGCP,
+	SECURITYTYPE_SWAP,
+	SECURITYTYPE_SWAPSPREAD,
+	SECURITYTYPE_SWAPTIONVOLATILITY,
+	SECURITYTYPE_SWEDISHCP,
+	SECURITYTYPE_SWINGLINE,
+	SECURITYTYPE_SYNTHLOC,
+	SECURITYTYPE_SYNTHREV,
+	SECURITYTYPE_SYNTHTERM,
+	SECURITYTYPE_SavingsPlan,
+	SECURITYTYPE_SavingsShare,
+	SECURITYTYPE_SecLending,
+	SECURITYTYPE_SingaporeDR,
+	SECURITYTYPE_Spotindex,
+	SECURITYTYPE_StapledSecurity,
+	SECURITYTYPE_StrategyTrade,
+	SECURITYTYPE_SwissCert,
+	SECURITYTYPE_SyntheticTerm,
+	SECURITYTYPE_TAIWANCP,
+	SECURITYTYPE_TAIWANCPGUAR,
+	SECURITYTYPE_TAIWANNEGOCD,
+	SECURITYTYPE_TAIWANTIMEDEPO,
+	SECURITYTYPE_TAXCREDIT,
+	SECURITYTYPE_TAXCREDITOID,
+	SECURITYTYPE_TDR,
+	SECURITYTYPE_TERM,
+	SECURITYTYPE_TERMDEPOSITS,
+	SECURITYTYPE_TERMGUARANTEEFAC,
+	SECURITYTYPE_TERMREV,
+	SECURITYTYPE_TERMVATTRNCH,
+	SECURITYTYPE_THAILANDCP,
+	SECURITYTYPE_TLTROTERM,
+	SECURITYTYPE_TREASURYBILL,
+	SECURITYTYPE_Term,
+	SECURITYTYPE_TrackingStk,
+	SECURITYTYPE_USCD,
+	SECURITYTYPE_USCP,
+	SECURITYTYPE_USINTBEARCP,
+	SECURITYTYPE_UIT,
+	SECURITYTYPE_UKGILTSTOCK,
+	SECURITYTYPE_UMBSMBSOther,
+	SECURITYTYPE_UNITRANCHE,
+	SECURITYTYPE_UNITRANCHEASSETBASEDREV,
+	SECURITYTYPE_UNITRANCHEDELAYDRAWPIKT,
+	SECURITYTYPE_UNITRANCHEDELAYDRAWTERM,
+	SECURITYTYPE_UNITRANCHEPIKTERM,
+	SECURITYTYPE_UNITRANCHEREV,
+	SECURITYTYPE_UNITRANCHETERM,
+	SECURITYTYPE_USDOMESTIC,
+	SECURITYTYPE_USGOVERNMENT,
+	SECURITYTYPE_USNONDOLLAR,
+	SECURITYTYPE_Unit,
+	SECURITYTYPE_UnitInvTst,
+	SECURITYTYPE_VARRATEDEMOBL,
+	SECURITYTYPE_VATTRNCH,
+	SECURITYTYPE_VENEZUELANCP,
+	SECURITYTYPE_VIETNAMESECD,
+	SECURITYTYPE_VOLATILITYDERIVATIVE,
+	SECURITYTYPE_Warrant,
+	SECURITYTYPE_YANKEE,
+	SECURITYTYPE_YANKEECD,
+	SECURITYTYPE_YENCD,
+	SECURITYTYPE_YENCP,
+	SECURITYTYPE_YieldCurve,
+	SECURITYTYPE_ZEROCOUPON,
+	SECURITYTYPE_ZEROCOUPONOID,
+}
+
+// Number of generated securityType values
+func SecurityTypeCount() int {
+	return len(securityTypeValues)
+}
+
+// Whether no securityType value was generated, e.g. after a broken run
+func SecurityTypeIsEmpty() bool {
+	return len(securityTypeValues) == 0
+}
+
+// Generated securityType values, sorted
+func SecurityTypes() []string {
+	return slices.Clone(securityTypeValues)
+}
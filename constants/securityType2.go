@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of securityType2, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type SecurityType2 string
+
 const (
 	SECURITYTYPE2_2NDLIEN                      = "2ND LIEN"
 	SECURITYTYPE2_ABS                          = "ABS"
@@ -9,12 +15,14 @@ const (
 	SECURITYTYPE2_ABSHG                        = "ABS/HG"
 	SECURITYTYPE2_ABSMEZZ                      = "ABS/MEZZ"
 	SECURITYTYPE2_BA                           = "BA"
-	SECURITYTYPE2_BaggedBriquettes             = "Bagged Briquettes"
-	SECURITYTYPE2_BaggedPellets                = "Bagged Pellets"
 	SECURITYTYPE2_BANKBILL                     = "BANK BILL"
 	SECURITYTYPE2_BANKERSACCEPTANCE            = "BANKERS ACCEPTANCE"
 	SECURITYTYPE2_BASISSWAP                    = "BASIS SWAP"
 	SECURITYTYPE2_BASIS_IMM                    = "BASIS_IMM"
+	SECURITYTYPE2_BN                           = "BN"
+	SECURITYTYPE2_BUTTERFLYSWAP                = "BUTTERFLY SWAP"
+	SECURITYTYPE2_BaggedBriquettes             = "Bagged Briquettes"
+	SECURITYTYPE2_BaggedPellets                = "Bagged Pellets"
 	SECURITYTYPE2_Bill                         = "Bill"
 	SECURITYTYPE2_Billet20MN                   = "Billet 20MN"
 	SECURITYTYPE2_Billet3803p                  = "Billet 3803p"
@@ -35,41 +43,39 @@ const (
 	SECURITYTYPE2_BilletLMEGrade8              = "Billet LME Grade 8"
 	SECURITYTYPE2_BilletLMEGrade9              = "Billet LME Grade 9"
 	SECURITYTYPE2_BilletQ235                   = "Billet Q235"
-	SECURITYTYPE2_BN                           = "BN"
 	SECURITYTYPE2_Bond                         = "Bond"
 	SECURITYTYPE2_BondNote                     = "Bond/Note"
 	SECURITYTYPE2_Briquettes                   = "Briquettes"
-	SECURITYTYPE2_BUTTERFLYSWAP                = "BUTTERFLY SWAP"
 	SECURITYTYPE2_CAPFLOOR                     = "CAPFLOOR"
 	SECURITYTYPE2_CAPSFLOORS                   = "CAPS & FLOORS"
 	SECURITYTYPE2_CASHRATE                     = "CASH RATE"
-	SECURITYTYPE2_Cathodes                     = "Cathodes"
-	SECURITYTYPE2_Cathodes100x100mm            = "Cathodes 100x100mm"
-	SECURITYTYPE2_Cathodes25x25mm              = "Cathodes 25x25mm"
-	SECURITYTYPE2_Cathodes50x50mm              = "Cathodes 50x50mm"
 	SECURITYTYPE2_CD                           = "CD"
 	SECURITYTYPE2_CDO2                         = "CDO2"
 	SECURITYTYPE2_CDS                          = "CDS"
 	SECURITYTYPE2_CDSCRP                       = "CDS(CRP)"
-	SECURITYTYPE2_Certificate                  = "Certificate"
 	SECURITYTYPE2_CMBS                         = "CMBS"
 	SECURITYTYPE2_CMO                          = "CMO"
-	SECURITYTYPE2_CoarseGrainPowder            = "Coarse Grain Powder"
-	SECURITYTYPE2_Comdty                       = "Comdty"
 	SECURITYTYPE2_COMMERCIALPAPER              = "COMMERCIAL PAPER"
-	SECURITYTYPE2_CommonStock                  = "Common Stock"
 	SECURITYTYPE2_CONTRACTFRA                  = "CONTRACT FRA"
-	SECURITYTYPE2_Corp                         = "Corp"
 	SECURITYTYPE2_CP                           = "CP"
 	SECURITYTYPE2_CRE                          = "CRE"
 	SECURITYTYPE2_CROSS                        = "CROSS"
 	SECURITYTYPE2_CRYPTO                       = "CRYPTO"
+	SECURITYTYPE2_Cathodes                     = "Cathodes"
+	SECURITYTYPE2_Cathodes100x100mm            = "Cathodes 100x100mm"
+	SECURITYTYPE2_Cathodes25x25mm              = "Cathodes 25x25mm"
+	SECURITYTYPE2_Cathodes50x50mm              = "Cathodes 50x50mm"
+	SECURITYTYPE2_Certificate                  = "Certificate"
+	SECURITYTYPE2_CoarseGrainPowder            = "Coarse Grain Powder"
+	SECURITYTYPE2_Comdty                       = "Comdty"
+	SECURITYTYPE2_CommonStock                  = "Common Stock"
+	SECURITYTYPE2_Corp                         = "Corp"
 	SECURITYTYPE2_Curncy                       = "Curncy"
-	SECURITYTYPE2_DailyFuture                  = "Daily Future"
 	SECURITYTYPE2_DEPOSIT                      = "DEPOSIT"
+	SECURITYTYPE2_DN                           = "DN"
+	SECURITYTYPE2_DailyFuture                  = "Daily Future"
 	SECURITYTYPE2_DepositaryReceipt            = "Depositary Receipt"
 	SECURITYTYPE2_Derived                      = "Derived"
-	SECURITYTYPE2_DN                           = "DN"
 	SECURITYTYPE2_Equity                       = "Equity"
 	SECURITYTYPE2_FDIC                         = "FDIC"
 	SECURITYTYPE2_FIXED_FLOAT                  = "FIXED_FLOAT"
@@ -79,92 +85,92 @@ const (
 	SECURITYTYPE2_FORWARDCROSS                 = "FORWARD CROSS"
 	SECURITYTYPE2_FORWARDCURVE                 = "FORWARD CURVE"
 	SECURITYTYPE2_FRA                          = "FRA"
-	SECURITYTYPE2_FullPlateCathodes            = "Full Plate Cathodes"
-	SECURITYTYPE2_Future                       = "Future"
 	SECURITYTYPE2_FWDSWAP                      = "FWD SWAP"
 	SECURITYTYPE2_FXCurve                      = "FX Curve"
+	SECURITYTYPE2_FullPlateCathodes            = "Full Plate Cathodes"
+	SECURITYTYPE2_Future                       = "Future"
 	SECURITYTYPE2_Generic                      = "Generic"
 	SECURITYTYPE2_Govt                         = "Govt"
 	SECURITYTYPE2_Granules                     = "Granules"
-	SECURITYTYPE2_Hedged                       = "Hedged"
 	SECURITYTYPE2_HF                           = "HF"
 	SECURITYTYPE2_HY                           = "HY"
+	SECURITYTYPE2_Hedged                       = "Hedged"
 	SECURITYTYPE2_IG                           = "IG"
 	SECURITYTYPE2_IMMFORWARD                   = "IMM FORWARD"
 	SECURITYTYPE2_IMMSWAP                      = "IMM SWAP"
-	SECURITYTYPE2_Index                        = "Index"
-	SECURITYTYPE2_INFL_FIXING_ZERO_COUPON      = "INFL_FIXING_ZERO_COUPON"
-	SECURITYTYPE2_INFL_FXFL_ZERO_COUPON        = "INFL_FXFL_ZERO_COUPON"
 	SECURITYTYPE2_INFLATIONSWAP                = "INFLATION SWAP"
 	SECURITYTYPE2_INFLATION_SWAP               = "INFLATION_SWAP"
+	SECURITYTYPE2_INFL_FIXING_ZERO_COUPON      = "INFL_FIXING_ZERO_COUPON"
+	SECURITYTYPE2_INFL_FXFL_ZERO_COUPON        = "INFL_FXFL_ZERO_COUPON"
+	SECURITYTYPE2_Index                        = "Index"
 	SECURITYTYPE2_Ingots                       = "Ingots"
 	SECURITYTYPE2_Ingots226DIN                 = "Ingots 226/DIN"
 	SECURITYTYPE2_IngotsA3801                  = "Ingots A380.1"
 	SECURITYTYPE2_IngotsAD121                  = "Ingots AD12.1"
 	SECURITYTYPE2_IngotsD12SJ1S                = "Ingots D12S/J1S"
 	SECURITYTYPE2_Jumbo                        = "Jumbo"
+	SECURITYTYPE2_LL                           = "LL"
+	SECURITYTYPE2_LL08                         = "LL08"
 	SECURITYTYPE2_LargeSows                    = "Large Sows"
 	SECURITYTYPE2_Largesows226                 = "Large sows 226"
 	SECURITYTYPE2_LargesowsA3801               = "Large sows A380.1"
 	SECURITYTYPE2_LargesowsAD121               = "Large sows AD12.1"
 	SECURITYTYPE2_LargesowsD12S                = "Large sows D12S"
-	SECURITYTYPE2_LL                           = "LL"
-	SECURITYTYPE2_LL08                         = "LL08"
 	SECURITYTYPE2_MMkt                         = "M-Mkt"
 	SECURITYTYPE2_MACSWAP                      = "MAC SWAP"
 	SECURITYTYPE2_MEZZ                         = "MEZZ"
 	SECURITYTYPE2_MML                          = "MML"
-	SECURITYTYPE2_MolybdenumCntdnRMCRoasted    = "Molybdenum Cntd n RMC(Roasted"
 	SECURITYTYPE2_MONEYMARKETCALL              = "MONEY MARKET CALL"
-	SECURITYTYPE2_Mtge                         = "Mtge"
 	SECURITYTYPE2_MTN                          = "MTN"
-	SECURITYTYPE2_Muni                         = "Muni"
 	SECURITYTYPE2_MUNISWAP                     = "MUNI SWAP"
+	SECURITYTYPE2_MolybdenumCntdnRMCRoasted    = "Molybdenum Cntd n RMC(Roasted"
+	SECURITYTYPE2_Mtge                         = "Mtge"
+	SECURITYTYPE2_Muni                         = "Muni"
 	SECURITYTYPE2_MutualFund                   = "Mutual Fund"
 	SECURITYTYPE2_NDFSWAP                      = "NDF SWAP"
-	SECURITYTYPE2_NickelRounds                 = "Nickel Rounds"
-	SECURITYTYPE2_NickelRoundsBag              = "Nickel Rounds Bag"
 	SECURITYTYPE2_NONDELIVERABLEFORWARD        = "NON-DELIVERABLE FORWARD"
 	SECURITYTYPE2_NONDELIVERABLEIRSSWAP        = "NON-DELIVERABLE IRS SWAP"
 	SECURITYTYPE2_NONDELIVERABLEOISSWAP        = "NON-DELIVERABLE OIS SWAP"
+	SECURITYTYPE2_NickelRounds                 = "Nickel Rounds"
+	SECURITYTYPE2_NickelRoundsBag              = "Nickel Rounds Bag"
 	SECURITYTYPE2_Note                         = "Note"
 	SECURITYTYPE2_ONSHOREFORWARD               = "ONSHORE FORWARD"
 	SECURITYTYPE2_ONSHORESWAP                  = "ONSHORE SWAP"
-	SECURITYTYPE2_Option                       = "Option"
 	SECURITYTYPE2_OPTIONVOLATILITY             = "OPTION VOLATILITY"
 	SECURITYTYPE2_OTHER                        = "OTHER"
 	SECURITYTYPE2_OVERNIGHTINDEXEDSWAP         = "OVERNIGHT INDEXED SWAP"
+	SECURITYTYPE2_Option                       = "Option"
 	SECURITYTYPE2_PAIR                         = "PAIR"
-	SECURITYTYPE2_PartnershipShares            = "Partnership Shares"
-	SECURITYTYPE2_Pellets                      = "Pellets"
-	SECURITYTYPE2_Pool                         = "Pool"
 	SECURITYTYPE2_PP12                         = "PP12"
 	SECURITYTYPE2_PP20                         = "PP20"
 	SECURITYTYPE2_PP25                         = "PP25"
 	SECURITYTYPE2_PP35                         = "PP3.5"
+	SECURITYTYPE2_PROMISSORYNOTE               = "PROMISSORY NOTE"
+	SECURITYTYPE2_PROPERTYSWAP                 = "PROPERTY SWAP"
+	SECURITYTYPE2_PartnershipShares            = "Partnership Shares"
+	SECURITYTYPE2_Pellets                      = "Pellets"
+	SECURITYTYPE2_Pool                         = "Pool"
 	SECURITYTYPE2_Preference                   = "Preference"
 	SECURITYTYPE2_PreferredStock               = "Preferred Stock"
-	SECURITYTYPE2_PROMISSORYNOTE               = "PROMISSORY NOTE"
 	SECURITYTYPE2_PromptForward                = "Prompt Forward"
-	SECURITYTYPE2_PROPERTYSWAP                 = "PROPERTY SWAP"
 	SECURITYTYPE2_QUARTERLYSWAP                = "QUARTERLY SWAP"
 	SECURITYTYPE2_REIT                         = "REIT"
 	SECURITYTYPE2_REPO                         = "REPO"
 	SECURITYTYPE2_RETURNIDX                    = "RETURN IDX"
-	SECURITYTYPE2_Right                        = "Right"
 	SECURITYTYPE2_RMBS                         = "RMBS"
+	SECURITYTYPE2_Right                        = "Right"
 	SECURITYTYPE2_Rounds                       = "Rounds"
+	SECURITYTYPE2_SME                          = "SME"
+	SECURITYTYPE2_SPOT                         = "SPOT"
+	SECURITYTYPE2_SWAP                         = "SWAP"
+	SECURITYTYPE2_SWAPSPREAD                   = "SWAP SPREAD"
+	SECURITYTYPE2_SWAPTIONVOLATILITY           = "SWAPTION VOLATILITY"
 	SECURITYTYPE2_SmallSows                    = "Small Sows"
 	SECURITYTYPE2_Smallsows226                 = "Small sows 226"
 	SECURITYTYPE2_SmallsowsA3801               = "Small sows A380.1"
 	SECURITYTYPE2_SmallsowsAD121               = "Small sows AD12.1"
 	SECURITYTYPE2_SmallsowsD12S                = "Small sows D12S"
-	SECURITYTYPE2_SME                          = "SME"
 	SECURITYTYPE2_Sows                         = "Sows"
-	SECURITYTYPE2_SPOT                         = "SPOT"
-	SECURITYTYPE2_SWAP                         = "SWAP"
-	SECURITYTYPE2_SWAPSPREAD                   = "SWAP SPREAD"
-	SECURITYTYPE2_SWAPTIONVOLATILITY           = "SWAPTION VOLATILITY"
 	SECURITYTYPE2_TBar                         = "T-Bar"
 	SECURITYTYPE2_TBars226                     = "T-Bars 226"
 	SECURITYTYPE2_TBarsA3801                   = "T-Bars A380.1"
@@ -181,3 +187,198 @@ const (
 	SECURITYTYPE2_WholeLoan                    = "Whole Loan"
 	SECURITYTYPE2_YieldCurve                   = "Yield Curve"
 )
+
+var securityType2Values = []string{
+	SECURITYTYPE2_2NDLIEN,
+	SECURITYTYPE2_ABS,
+	SECURITYTYPE2_ABSOther,
+	SECURITYTYPE2_ABSHG,
+	SECURITYTYPE2_ABSMEZZ,
+	SECURITYTYPE2_BA,
+	SECURITYTYPE2_BANKBILL,
+	SECURITYTYPE2_BANKERSACCEPTANCE,
+	SECURITYTYPE2_BASISSWAP,
+	SECURITYTYPE2_BASIS_IMM,
+	SECURITYTYPE2_BN,
+	SECURITYTYPE2_BUTTERFLYSWAP,
+	SECURITYTYPE2_BaggedBriquettes,
+	SECURITYTYPE2_BaggedPellets,
+	SECURITYTYPE2_Bill,
+	SECURITYTYPE2_Billet20MN,
+	SECURITYTYPE2_Billet3803p,
+	SECURITYTYPE2_Billet3803s,
+	SECURITYTYPE2_Billet3803sp,
+	SECURITYTYPE2_Billet3805p,
+	SECURITYTYPE2_Billet3805s,
+	SECURITYTYPE2_Billet3805sp,
+	SECURITYTYPE2_BilletA61560,
+	SECURITYTYPE2_BilletBS4449,
+	SECURITYTYPE2_BilletLMEGrade1,
+	SECURITYTYPE2_BilletLMEGrade2,
+	SECURITYTYPE2_BilletLMEGrade3,
+	SECURITYTYPE2_BilletLMEGrade4,
+	SECURITYTYPE2_BilletLMEGrade5,
+	SECURITYTYPE2_BilletLMEGrade6,
+	SECURITYTYPE2_BilletLMEGrade7,
+	SECURITYTYPE2_BilletLMEGrade8,
+	SECURITYTYPE2_BilletLMEGrade9,
+	SECURITYTYPE2_BilletQ235,
+	SECURITYTYPE2_Bond,
+	SECURITYTYPE2_BondNote,
+	SECURITYTYPE2_Briquettes,
+	SECURITYTYPE2_CAPFLOOR,
+	SECURITYTYPE2_CAPSFLOORS,
+	SECURITYTYPE2_CASHRATE,
+	SECURITYTYPE2_CD,
+	SECURITYTYPE2_CDO2,
+	SECURITYTYPE2_CDS,
+	SECURITYTYPE2_CDSCRP,
+	SECURITYTYPE2_CMBS,
+	SECURITYTYPE2_CMO,
+	SECURITYTYPE2_COMMERCIALPAPER,
+	SECURITYTYPE2_CONTRACTFRA,
+	SECURITYTYPE2_CP,
+	SECURITYTYPE2_CRE,
+	SECURITYTYPE2_CROSS,
+	SECURITYTYPE2_CRYPTO,
+	SECURITYTYPE2_Cathodes,
+	SECURITYTYPE2_Cathodes100x100mm,
+	SECURITYTYPE2_Cathodes25x25mm,
+	SECURITYTYPE2_Cathodes50x50mm,
+	SECURITYTYPE2_Certificate,
+	SECURITYTYPE2_CoarseGrainPowder,
+	SECURITYTYPE2_Comdty,
+	SECURITYTYPE2_CommonStock,
+	SECURITYTYPE2_Corp,
+	SECURITYTYPE2_Curncy,
+	SECURITYTYPE2_DEPOSIT,
+	SECURITYTYPE2_DN,
+	SECURITYTYPE2_DailyFuture,
+	SECURITYTYPE2_DepositaryReceipt,
+	SECURITYTYPE2_Derived,
+	SECURITYTYPE2_Equity,
+	SECURITYTYPE2_FDIC,
+	SECURITYTYPE2_FIXED_FLOAT,
+	SECURITYTYPE2_FIXED_FLOAT_FORWARD_STARTING,
+	SECURITYTYPE2_FIXINGRATE,
+	SECURITYTYPE2_FORWARD,
+	SECURITYTYPE2_FORWARDCROSS,
+	SECURITYTYPE2_FORWARDCURVE,
+	SECURITYTYPE2_FRA,
+	SECURITYTYPE2_FWDSWAP,
+	SECURITYTYPE2_FXCurve,
+	SECURITYTYPE2_FullPlateCathodes,
+	SECURITYTYPE2_Future,
+	SECURITYTYPE2_Generic,
+	SECURITYTYPE2_Govt,
+	SECURITYTYPE2_Granules,
+	SECURITYTYPE2_HF,
+	SECURITYTYPE2_HY,
+	SECURITYTYPE2_Hedged,
+	SECURITYTYPE2_IG,
+	SECURITYTYPE2_IMMFORWARD,
+	SECURITYTYPE2_IMMSWAP,
+	SECURITYTYPE2_INFLATIONSWAP,
+	SECURITYTYPE2_INFLATION_SWAP,
+	SECURITYTYPE2_INFL_FIXING_ZERO_COUPON,
+	SECURITYTYPE2_INFL_FXFL_ZERO_COUPON,
+	SECURITYTYPE2_Index,
+	SECURITYTYPE2_Ingots,
+	SECURITYTYPE2_Ingots226DIN,
+	SECURITYTYPE2_IngotsA3801,
+	SECURITYTYPE2_IngotsAD121,
+	SECURITYTYPE2_IngotsD12SJ1S,
+	SECURITYTYPE2_Jumbo,
+	SECURITYTYPE2_LL,
+	SECURITYTYPE2_LL08,
+	SECURITYTYPE2_LargeSows,
+	SECURITYTYPE2_Largesows226,
+	SECURITYTYPE2_LargesowsA3801,
+	SECURITYTYPE2_LargesowsAD121,
+	SECURITYTYPE2_LargesowsD12S,
+	SECURITYTYPE2_MMkt,
+	SECURITYTYPE2_MACSWAP,
+	SECURITYTYPE2_MEZZ,
+	SECURITYTYPE2_MML,
+	SECURITYTYPE2_MONEYMARKETCALL,
+	SECURITYTYPE2_MTN,
+	SECURITYTYPE2_MUNISWAP,
+	SECURITYTYPE2_MolybdenumCntdnRMCRoasted,
+	SECURITYTYPE2_Mtge,
+	SECURITYTYPE2_Muni,
+	SECURITYTYPE2_MutualFund,
+	SECURITYTYPE2_NDFSWAP,
+	SECURITYTYPE2_NONDELIVERABLEFORWARD,
+	SECURITYTYPE2_NONDELIVERABLEIRSSWAP,
+	SECURITYTYPE2_NONDELIVERABLEOISSWAP,
+	SECURITYTYPE2_NickelRounds,
+	SECURITYTYPE2_NickelRoundsBag,
+	SECURITYTYPE2_Note,
+	SECURITYTYPE2_ONSHOREFORWARD,
+	SECURITYTYPE2_ONSHORESWAP,
+	SECURITYTYPE2_OPTIONVOLATILITY,
+	SECURITYTYPE2_OTHER,
+	SECURITYTYPE2_OVERNIGHTINDEXEDSWAP,
+	SECURITYTYPE2_Option,
+	SECURITYTYPE2_PAIR,
+	SECURITYTYPE2_PP12,
+	SECURITYTYPE2_PP20,
+	SECURITYTYPE2_PP25,
+	SECURITYTYPE2_PP35,
+	SECURITYTYPE2_PROMISSORYNOTE,
+	SECURITYTYPE2_PROPERTYSWAP,
+	SECURITYTYPE2_PartnershipShares,
+	SECURITYTYPE2_Pellets,
+	SECURITYTYPE2_Pool,
+	SECURITYTYPE2_Preference,
+	SECURITYTYPE2_PreferredStock,
+	SECURITYTYPE2_PromptForward,
+	SECURITYTYPE2_QUARTERLYSWAP,
+	SECURITYTYPE2_REIT,
+	SECURITYTYPE2_REPO,
+	SECURITYTYPE2_RETURNIDX,
+	SECURITYTYPE2_RMBS,
+	SECURITYTYPE2_Right,
+	SECURITYTYPE2_Rounds,
+	SECURITYTYPE2_SME,
+	SECURITYTYPE2_SPOT,
+	SECURITYTYPE2_SWAP,
+	SECURITYTYPE2_SWAPSPREAD,
+	SECURITYTYPE2_SWAPTIONVOLATILITY,
+	SECURITYTYPE2_SmallSows,
+	SECURITYTYPE2_Smallsows226,
+	SECURITYTYPE2_SmallsowsA3801,
+	SECURITYTYPE2_SmallsowsAD121,
+	SECURITYTYPE2_SmallsowsD12S,
+	SECURITYTYPE2_Sows,
+	SECURITYTYPE2_TBar,
+	SECURITYTYPE2_TBars226,
+	SECURITYTYPE2_TBarsA3801,
+	SECURITYTYPE2_TBarsAD121,
+	SECURITYTYPE2_TBarsD12S,
+	SECURITYTYPE2_TBA,
+	SECURITYTYPE2_TD,
+	SECURITYTYPE2_TREASURYBILL,
+	SECURITYTYPE2_TRP,
+	SECURITYTYPE2_Unit,
+	SECURITYTYPE2_UnitInvestmentTrust,
+	SECURITYTYPE2_VOLATILITYDERIVATIVE,
+	SECURITYTYPE2_Warrant,
+	SECURITYTYPE2_WholeLoan,
+	SECURITYTYPE2_YieldCurve,
+}
+
+// Number of generated securityType2 values
+func SecurityType2Count() int {
+	return len(securityType2Values)
+}
+
+// Whether no securityType2 value was generated, e.g. after a broken run
+func SecurityType2IsEmpty() bool {
+	return len(securityType2Values) == 0
+}
+
+// Generated securityType2 values, sorted
+func SecurityType2s() []string {
+	return slices.Clone(securityType2Values)
+}
@@ -2,6 +2,12 @@ package constants
 
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of stateCode, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type StateCode string
+
 const (
 	STATECODE_AB = "AB"
 	STATECODE_AC = "AC"
@@ -146,3 +152,163 @@ const (
 	STATECODE_YU = "YU"
 	STATECODE_ZJ = "ZJ"
 )
+
+var stateCodeValues = []string{
+	STATECODE_AB,
+	STATECODE_AC,
+	STATECODE_AH,
+	STATECODE_AK,
+	STATECODE_AL,
+	STATECODE_AM,
+	STATECODE_AR,
+	STATECODE_AS,
+	STATECODE_AT,
+	STATECODE_AZ,
+	STATECODE_BC,
+	STATECODE_BJ,
+	STATECODE_CA,
+	STATECODE_CB,
+	STATECODE_CO,
+	STATECODE_CQ,
+	STATECODE_CT,
+	STATECODE_CZ,
+	STATECODE_DC,
+	STATECODE_DE,
+	STATECODE_EH,
+	STATECODE_FH,
+	STATECODE_FI,
+	STATECODE_FJ,
+	STATECODE_FL,
+	STATECODE_FO,
+	STATECODE_FS,
+	STATECODE_GA,
+	STATECODE_GD,
+	STATECODE_GF,
+	STATECODE_GM,
+	STATECODE_GS,
+	STATECODE_GU,
+	STATECODE_GX,
+	STATECODE_GZ,
+	STATECODE_HA,
+	STATECODE_HB,
+	STATECODE_HE,
+	STATECODE_HG,
+	STATECODE_HI,
+	STATECODE_HL,
+	STATECODE_HN,
+	STATECODE_HO,
+	STATECODE_HS,
+	STATECODE_IA,
+	STATECODE_ID,
+	STATECODE_IG,
+	STATECODE_IK,
+	STATECODE_IL,
+	STATECODE_IN,
+	STATECODE_IT,
+	STATECODE_JL,
+	STATECODE_JS,
+	STATECODE_JX,
+	STATECODE_KA,
+	STATECODE_KC,
+	STATECODE_KN,
+	STATECODE_KO,
+	STATECODE_KS,
+	STATECODE_KT,
+	STATECODE_KU,
+	STATECODE_KY,
+	STATECODE_LA,
+	STATECODE_LN,
+	STATECODE_MA,
+	STATECODE_MB,
+	STATECODE_MD,
+	STATECODE_ME,
+	STATECODE_MG,
+	STATECODE_MI,
+	STATECODE_MN,
+	STATECODE_MO,
+	STATECODE_MS,
+	STATECODE_MT,
+	STATECODE_MZ,
+	STATECODE_NB,
+	STATECODE_NC,
+	STATECODE_ND,
+	STATECODE_NE,
+	STATECODE_NG,
+	STATECODE_NH,
+	STATECODE_NJ,
+	STATECODE_NL,
+	STATECODE_NM,
+	STATECODE_NN,
+	STATECODE_NR,
+	STATECODE_NS,
+	STATECODE_NT,
+	STATECODE_NU,
+	STATECODE_NV,
+	STATECODE_NW,
+	STATECODE_NX,
+	STATECODE_NY,
+	STATECODE_OH,
+	STATECODE_OK,
+	STATECODE_ON,
+	STATECODE_OR,
+	STATECODE_OS,
+	STATECODE_OT,
+	STATECODE_OY,
+	STATECODE_PA,
+	STATECODE_PE,
+	STATECODE_PR,
+	STATECODE_QC,
+	STATECODE_QH,
+	STATECODE_QL,
+	STATECODE_RI,
+	STATECODE_SA,
+	STATECODE_SC,
+	STATECODE_SD,
+	STATECODE_SH,
+	STATECODE_SI,
+	STATECODE_SK,
+	STATECODE_SN,
+	STATECODE_ST,
+	STATECODE_SX,
+	STATECODE_SZ,
+	STATECODE_TA,
+	STATECODE_TG,
+	STATECODE_TJ,
+	STATECODE_TK,
+	STATECODE_TN,
+	STATECODE_TS,
+	STATECODE_TT,
+	STATECODE_TX,
+	STATECODE_TY,
+	STATECODE_UT,
+	STATECODE_VA,
+	STATECODE_VI,
+	STATECODE_VT,
+	STATECODE_WA,
+	STATECODE_WI,
+	STATECODE_WK,
+	STATECODE_WV,
+	STATECODE_WY,
+	STATECODE_XJ,
+	STATECODE_XZ,
+	STATECODE_YA,
+	STATECODE_YN,
+	STATECODE_YT,
+	STATECODE_YU,
+	STATECODE_ZJ,
+}
+
+// Number of generated stateCode values
+func StateCodeCount() int {
+	return len(stateCodeValues)
+}
+
+// Whether no stateCode value was generated, e.g. after a broken run
+func StateCodeIsEmpty() bool {
+	return len(stateCodeValues) == 0
+}
+
+// Generated stateCode values, sorted
+func StateCodes() []string {
+	return slices.Clone(stateCodeValues)
+}
@@ -0,0 +1,33 @@
+package constants
+
+// Keys of the values endpoint, `/mapping/values/{key}`
+const (
+	ValueKeyIDType        = "idType"
+	ValueKeyExchCode      = "exchCode"
+	ValueKeyMicCode       = "micCode"
+	ValueKeyCurrency      = "currency"
+	ValueKeyMarketSecDes  = "marketSecDes"
+	ValueKeySecurityType  = "securityType"
+	ValueKeySecurityType2 = "securityType2"
+	ValueKeyStateCode     = "stateCode"
+)
+
+// Every key of the values endpoint, the properties this package is generated from
+//
+// Usage:
+//
+//	for _, key := range ValueKeys() {
+//		values, err := openfigi.FetchValues(ctx, key)
+//	}
+func ValueKeys() []string {
+	return []string{
+		ValueKeyIDType,
+		ValueKeyExchCode,
+		ValueKeyMicCode,
+		ValueKeyCurrency,
+		ValueKeyMarketSecDes,
+		ValueKeySecurityType,
+		ValueKeySecurityType2,
+		ValueKeyStateCode,
+	}
+}
@@ -0,0 +1,65 @@
+package openfigi
+
+// ========================= COST =========================
+
+// API usage of a call, to budget against the quotas
+type CostEstimate struct {
+	Jobs     int // Jobs, counted against the job quota: one per mapping item or page
+	Requests int // HTTP requests, counted against the request rate limit
+	// The cost has no known bound, e.g. a search with more pages and no page
+	// limit; Jobs and Requests then count the pages known so far only
+	Unbounded bool
+}
+
+// Number of mapping jobs in the request, one per item
+func (m_req MappingRequest) JobCount() int {
+	return len(m_req)
+}
+
+// Cost of [MappingRequest.FetchAll] with the current API key, see [MaxMappingJobs]
+func (m_req MappingRequest) CostEstimate() CostEstimate {
+	return CostEstimate{
+		Jobs:     m_req.JobCount(),
+		Requests: (len(m_req) + MaxMappingJobs() - 1) / MaxMappingJobs(),
+	}
+}
+
+// Number of jobs of a page: a search or filter call is one job
+func (searchRes SearchResponse) JobCount() int {
+	return 1
+}
+
+// Upper bound of the cost of collecting every page of this search, this one
+// included, fetching at most maxPages (0 for no limit). Without a total, a
+// search with more pages is assumed to need all maxPages. With no limit,
+// such a search has no bound: the estimate counts this page and is Unbounded.
+//
+// Usage:
+//
+//	cost := res.CostEstimate(0)
+//	if cost.Unbounded || cost.Jobs > budget { ... }
+func (searchRes SearchResponse) CostEstimate(maxPages int) CostEstimate {
+	if !searchRes.HasMore() {
+		return CostEstimate{Jobs: 1, Requests: 1}
+	}
+	if maxPages <= 0 {
+		return CostEstimate{Jobs: 1, Requests: 1, Unbounded: true}
+	}
+	return CostEstimate{Jobs: maxPages, Requests: maxPages}
+}
+
+// Cost of collecting the pages of this filter from this one on, fetching at
+// most maxPages (0 for no limit): [FilterResponse.EstimatedPages] minus the
+// pages fetched before this one when paginating with Next
+//
+// Usage:
+//
+//	res, _ := item.Filter("", FirstPage)
+//	if res.CostEstimate(0).Jobs > budget { ... }
+func (filterRes FilterResponse) CostEstimate(maxPages int) CostEstimate {
+	pages := max(filterRes.EstimatedPages()-filterRes.pagesBefore, 1)
+	if maxPages > 0 {
+		pages = min(pages, maxPages)
+	}
+	return CostEstimate{Jobs: pages, Requests: pages}
+}
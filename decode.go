@@ -0,0 +1,76 @@
+package openfigi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// ========================= DECODING =========================
+
+// Decode a captured /mapping response body, e.g. a fixture or an audit log,
+// as [MappingRequest.Fetch] does, honoring [StrictDecode] and [KeepRaw].
+//
+// Usage:
+//
+//	f, _ := os.Open("mapping.json")
+//	res, err := DecodeMappingResponses(f)
+func DecodeMappingResponses(r io.Reader) ([]SingleMappingResponse, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeMappingResponses(body)
+}
+
+// Decode a captured /search response body, as [BaseItem.Search] does.
+// The response does not know its item and query, so Next() cannot follow it;
+// search with its NextHash instead.
+func DecodeSearchResponse(r io.Reader) (SearchResponse, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return SearchResponse{}, err
+	}
+	return decodePage[SearchResponse](body)
+}
+
+// Decode a captured /filter response body, as [BaseItem.Filter] does.
+// Next() cannot follow it, see [DecodeSearchResponse].
+func DecodeFilterResponse(r io.Reader) (FilterResponse, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return FilterResponse{}, err
+	}
+	return decodePage[FilterResponse](body)
+}
+
+func decodeMappingResponses(body []byte) (res []SingleMappingResponse, err error) {
+	if err = decodeBody(body, &res); err != nil || !KeepRaw() {
+		return
+	}
+	var raws []json.RawMessage
+	if err = json.Unmarshal(body, &raws); err != nil {
+		return
+	}
+	for i := range min(len(res), len(raws)) {
+		res[i].Raw = raws[i]
+	}
+	return
+}
+
+func decodePage[T any](body []byte) (res T, err error) {
+	err = decodeBody(body, &res)
+	if r, ok := any(&res).(interface{ setRaw(json.RawMessage) }); ok && KeepRaw() {
+		r.setRaw(bytes.Clone(body))
+	}
+	return
+}
+
+// Decode a response body, disallowing unknown fields under [StrictDecode]
+func decodeBody(body []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if StrictDecode() {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(v)
+}
@@ -0,0 +1,60 @@
+package openfigi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Low-level escape hatch for endpoints the typed API does not cover.
+//
+// Sends body as JSON (nil for none) to path, relative to [APIBaseUrl], with the
+// package headers and API key, after waiting on the [RateLimiter]. There are no
+// retries and no [Cache]. Error statuses return an [APIError] and a nil response;
+// otherwise the caller decodes and closes the response body.
+//
+// Usage:
+//
+//	resp, err := DoRequest(ctx, "GET", "/mapping/values/exchCode", nil)
+//	if err != nil {
+//		return err
+//	}
+//	defer resp.Body.Close()
+func DoRequest(ctx context.Context, method string, path string, body any) (*http.Response, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	if err := waitRateLimiter(ctx); err != nil {
+		return nil, err
+	}
+	cfg := configSnapshot()
+	req, err := newRequest(ctx, cfg, method, path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	sentAt := time.Now()
+	resp, err := cfg.client.Do(req)
+	if err != nil {
+		emitMetric(path, 0, time.Since(sentAt), err)
+		return nil, err
+	}
+	setLastRateLimit(parseRateLimit(resp.Header))
+
+	details, ok := httpStatusMap[resp.StatusCode]
+	if !ok {
+		emitMetric(path, resp.StatusCode, time.Since(sentAt), nil)
+		return resp, nil
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	apiErr := statusError(req, cfg.baseURL, resp.StatusCode, details, respBody)
+	emitMetric(path, resp.StatusCode, time.Since(sentAt), apiErr)
+	return nil, apiErr
+}
@@ -0,0 +1,114 @@
+package openfigi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Returned by Next() on the last page
+//
+// Usage:
+//
+//	if errors.Is(err, ErrNoMoreResults) {
+//		break
+//	}
+var ErrNoMoreResults = errors.New("no more results")
+
+// Returned by [MappingRequest.Fetch] for a request without items,
+// instead of sending it for the API to reject
+var ErrEmptyRequest = errors.New("empty mapping request")
+
+// Matched by the [APIError] of a 429 response, once retries are exhausted
+//
+// Usage:
+//
+//	if errors.Is(err, ErrRateLimited) {
+//		time.Sleep(LastRateLimit().Reset)
+//	}
+var ErrRateLimited = errors.New("rate limited")
+
+// Returned by pagination under [CollectOptions.ContinueOnEmptyPage] after
+// [MaxConsecutiveEmptyPages] empty pages in a row
+var ErrTooManyEmptyPages = errors.New("too many empty pages")
+
+// Error reported in the body of a successful response, e.g. the `error`
+// field of a search page or of a mapping job
+type ResponseError struct {
+	Message string
+}
+
+func (e *ResponseError) Error() string {
+	return e.Message
+}
+
+// Error returned when the API responds with an error status or an unusable body
+type APIError struct {
+	StatusCode int
+	// Explanation of the status code
+	Details string
+	// What was wrong with a response that had a successful status, e.g. its content type
+	Message string
+	// Response body, only kept when [SetLogBodies] is on
+	Body []byte
+	// The body was not JSON, e.g. a maintenance or outage page
+	Maintenance bool
+	// Requested URL, e.g. to spot a misconfigured base URL behind a 404
+	URL string
+}
+
+// The status code, e.g. "429", followed by the message if any
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return strconv.Itoa(e.StatusCode) + ": " + e.Message
+	}
+	return strconv.Itoa(e.StatusCode)
+}
+
+// Whether the error is [ErrRateLimited], for a 429 status
+func (e *APIError) Is(target error) bool {
+	return target == ErrRateLimited && e.StatusCode == http.StatusTooManyRequests
+}
+
+func newAPIError(statusCode int, details string, body []byte) *APIError {
+	err := &APIError{StatusCode: statusCode, Details: details}
+	if LogBodies() {
+		err.Body = body
+	}
+	return err
+}
+
+// [APIError] of an error status answering req, sent to baseURL, explaining
+// the statuses caused by the request itself
+func statusError(req *http.Request, baseURL string, statusCode int, details string, body []byte) *APIError {
+	err := newAPIError(statusCode, details, body)
+	err.URL = req.URL.String()
+	switch statusCode {
+	case http.StatusNotFound:
+		err.Message = fmt.Sprintf("nothing at %s, check the base URL %s and the endpoints", err.URL, baseURL)
+	case http.StatusNotAcceptable:
+		err.Message = fmt.Sprintf("the API cannot answer `Accept: %s`", req.Header.Get("Accept"))
+	}
+	return err
+}
+
+// Bytes of an unexpected body quoted in [APIError.Message] under [LogBodies]
+const snippetSize = 256
+
+// Start of the body, for error messages
+func snippet(body []byte) string {
+	if len(body) > snippetSize {
+		return string(body[:snippetSize]) + "..."
+	}
+	return string(body)
+}
+
+// ": " and the start of the body to end an error message with, or nothing
+// unless [LogBodies], keeping identifiers out of errors as out of logs
+func bodySnippet(body []byte) string {
+	if !LogBodies() {
+		return ""
+	}
+	return ": " + snippet(body)
+}
@@ -10,22 +10,56 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"text/template"
+	"time"
 )
 
 const folder = "constants"
 
 const hashSetFile = "values.go"
 
+const versionFile = "version.go"
+
+// OpenFIGI API version the values are fetched from
+const apiVersion = "v3"
+
 const enumTemplate = `package ` + folder + `
 // Code generated by go generate; DO NOT EDIT.
 
+import "slices"
+
+// Value of {{ .Prop }}, accepted by the typed setters of openfigi.
+// The constants below are untyped, so they fit both this type and string.
+type {{ .Exported }} string
+
 const (
 {{- range .Constants }}
     {{ .Name }} = "{{ .Value }}"
 {{- end }}
 )
+
+var {{ .Prop }}Values = []string{
+{{- range .Constants }}
+	{{ .Name }},
+{{- end }}
+}
+
+// Number of generated {{ .Prop }} values
+func {{ .Exported }}Count() int {
+	return len({{ .Prop }}Values)
+}
+
+// Whether no {{ .Prop }} value was generated, e.g. after a broken run
+func {{ .Exported }}IsEmpty() bool {
+	return len({{ .Prop }}Values) == 0
+}
+
+// Generated {{ .Prop }} values, sorted
+func {{ .Plural }}() []string {
+	return slices.Clone({{ .Prop }}Values)
+}
 `
 
 const hashSetTemplate = `
@@ -36,7 +70,20 @@ var {{ .Prop }}Set = sets.New(
 )
 `
 
+const versionTemplate = `package ` + folder + `
+// Code generated by go generate; DO NOT EDIT.
+
+import "time"
+
+// When the values were fetched from the API, zero if unknown
+var ValuesGeneratedAt = time.Date({{ .Year }}, {{ printf "%d" .Month }}, {{ .Day }}, {{ .Hour }}, {{ .Minute }}, {{ .Second }}, 0, time.UTC)
+
+// OpenFIGI API version the values were fetched from
+const ValuesSourceVersion = "{{ .Version }}"
+`
+
 func main() {
+	// Keep in sync with constants.ValueKeys
 	props := []string{
 		"idType",
 		"exchCode",
@@ -70,10 +117,37 @@ func main() {
 
 	for _, prop := range props {
 		values := getValues(prop)
+		slices.Sort(values) // Deterministic output, whatever the API order
 		slog.Info(fmt.Sprintf("Generating %s with %d values", prop, len(values)))
 		enumGen(prop, values)
 		hashSetGen(prop, values)
 	}
+
+	versionGen(time.Now().UTC())
+}
+
+func versionGen(generatedAt time.Time) {
+	tmpl, err := template.New("version").Parse(versionTemplate)
+	if err != nil {
+		panic(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		time.Time
+		Version string
+	}{generatedAt, apiVersion}); err != nil {
+		panic(err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(folder, versionFile), formatted, 0644); err != nil {
+		panic(err)
+	}
 }
 
 func hashSetGen(property string, values []string) {
@@ -146,7 +220,9 @@ func enumGen(property string, values []string) {
 	if err := tmpl.Execute(&buf, struct {
 		Constants []keyVal
 		Prop      string
-	}{constants, property}); err != nil {
+		Exported  string
+		Plural    string
+	}{constants, property, exportedName(property), pluralName(property)}); err != nil {
 		panic(err)
 	}
 
@@ -160,8 +236,33 @@ func enumGen(property string, values []string) {
 	}
 }
 
+// Exported Go name of a property, e.g. exchCode -> ExchCode, idType -> IDType
+func exportedName(property string) string {
+	if strings.HasPrefix(property, "id") {
+		return "ID" + property[2:]
+	}
+	return strings.ToUpper(property[:1]) + property[1:]
+}
+
+// Exported plural of a property, e.g. exchCode -> ExchCodes, currency -> Currencies.
+// A name already ending in s, e.g. marketSecDes, takes Values so as not to
+// clash with its type, and so does idType, whose IDTypes is hand-written with
+// the descriptions.
+func pluralName(property string) string {
+	name := exportedName(property)
+	switch {
+	case property == "idType":
+		return name + "Values"
+	case strings.HasSuffix(name, "y"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"):
+		return name + "Values"
+	}
+	return name + "s"
+}
+
 func getValues(property string) []string {
-	url := fmt.Sprintf("https://api.openfigi.com/v3/mapping/values/%s", property)
+	url := fmt.Sprintf("https://api.openfigi.com/%s/mapping/values/%s", apiVersion, property)
 	slog.Info(fmt.Sprintf("GET %s", property))
 	resp, err := http.Get(url)
 	if err != nil {
@@ -0,0 +1,154 @@
+package openfigi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/minh-dng/openfigi-go/constants"
+)
+
+// ========================= IDTYPE INFERENCE =========================
+
+// Guess the idType of a raw identifier from its length, shape and check digit:
+// a FIGI (`ID_BB_GLOBAL`), an ISIN (`ID_ISIN`) or a CUSIP (`ID_CUSIP`).
+// Surrounding whitespace is ignored and letters may be lowercase.
+// ok is false if the value matches none of them.
+//
+// Usage:
+//
+//	idType, ok := InferIDType("US0378331005") // ID_ISIN, true
+func InferIDType(value string) (idType IDType, ok bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	switch {
+	case isFIGI(value):
+		return constants.IDTYPE_ID_BB_GLOBAL, true
+	case isISIN(value):
+		return constants.IDTYPE_ID_ISIN, true
+	case isCUSIP(value):
+		return constants.IDTYPE_ID_CUSIP, true
+	}
+	return "", false
+}
+
+// A value mapped by [MapAuto], with the idType inferred for it
+type AutoMapping struct {
+	Value    string
+	IDType   IDType // Empty if it could not be inferred
+	Inferred bool   // Whether the value was sent; if not, Response is empty
+	Response SingleMappingResponse
+}
+
+// Map raw identifiers of unknown idType, inferring each with [InferIDType].
+// Values that cannot be inferred are not sent. The result has one entry per
+// value, in order, holding the inference to verify; the request is chunked and
+// fetched as by [MappingRequest.FetchAll], so a failed chunk leaves its
+// responses empty and its error is joined into err.
+//
+// Usage:
+//
+//	res, err := MapAuto(ctx, "US0378331005", "BBG000BLNNH6", "037833100")
+//	for _, r := range res {
+//		fmt.Println(r.Value, r.IDType, r.Response.Data)
+//	}
+func MapAuto(ctx context.Context, values ...string) ([]AutoMapping, error) {
+	res := make([]AutoMapping, len(values))
+	var req MappingRequest
+	var sent []int // Index in res of each item of req
+	for i, value := range values {
+		res[i].Value = value
+		idType, ok := InferIDType(value)
+		if !ok {
+			continue
+		}
+		res[i].IDType, res[i].Inferred = idType, true
+		req = append(req, MappingItem{Type: idType, Value: strings.ToUpper(strings.TrimSpace(value))})
+		sent = append(sent, i)
+	}
+	if len(req) == 0 {
+		return res, nil
+	}
+
+	_, err := req.FetchAllProgress(ctx, 1, func(_, _, start int, chunkRes []SingleMappingResponse, _ error) {
+		for j, r := range chunkRes {
+			if start+j < len(sent) {
+				res[sent[start+j]].Response = r
+			}
+		}
+	})
+	return res, err
+}
+
+// Value of an alphanumeric character in check digits: 0-9, then A=10 to Z=35.
+// -1 for other characters.
+func charValue(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return -1
+}
+
+// Check digit shared by CUSIPs and FIGIs: the digits of the character values
+// are summed, every second value doubled first
+func modulus10DoubleAddDouble(s string) (int, bool) {
+	sum := 0
+	for i := range len(s) {
+		v := charValue(s[i])
+		if v < 0 {
+			return 0, false
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return (10 - sum%10) % 10, true
+}
+
+// 12 characters: 2 consonants, G, 8 alphanumerics and a check digit
+func isFIGI(s string) bool {
+	if len(s) != 12 || s[2] != 'G' || strings.ContainsAny(s[:2], "AEIOU") ||
+		charValue(s[0]) < 10 || charValue(s[1]) < 10 {
+		return false
+	}
+	check, ok := modulus10DoubleAddDouble(s[:11])
+	return ok && int(s[11]-'0') == check
+}
+
+// 12 characters: a 2-letter country code, 9 alphanumerics and a Luhn check
+// digit over the digits of the character values
+func isISIN(s string) bool {
+	if len(s) != 12 || charValue(s[0]) < 10 || charValue(s[1]) < 10 {
+		return false
+	}
+	var digits strings.Builder
+	for i := range 11 {
+		v := charValue(s[i])
+		if v < 0 {
+			return false
+		}
+		digits.WriteString(strconv.Itoa(v))
+	}
+	sum := 0
+	d := digits.String()
+	for i := range len(d) {
+		v := int(d[len(d)-1-i] - '0')
+		if i%2 == 0 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return int(s[11]-'0') == (10-sum%10)%10
+}
+
+// 9 characters: 8 alphanumerics and a check digit
+func isCUSIP(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	check, ok := modulus10DoubleAddDouble(s[:8])
+	return ok && int(s[8]-'0') == check
+}
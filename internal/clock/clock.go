@@ -0,0 +1,42 @@
+// Time source of the retry waits and rate limiting of openfigi, swapped for a
+// fake by testutil.SetClock so they can be tested without real delays.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+}
+
+// The time package
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+var current = struct {
+	sync.RWMutex
+	value Clock
+}{value: realClock{}}
+
+// Use c from now on; nil restores the real clock
+func Set(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	current.Lock()
+	defer current.Unlock()
+	current.value = c
+}
+
+func Get() Clock {
+	current.RLock()
+	defer current.RUnlock()
+	return current.value
+}
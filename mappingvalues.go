@@ -0,0 +1,175 @@
+package openfigi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/minh-dng/openfigi-go/constants"
+)
+
+// ========================= MAPPING VALUES =========================
+
+// Properties with a values endpoint, `/mapping/values/{key}`
+var valueKeys = constants.ValueKeys()
+
+type valuesEntry struct {
+	values    []string
+	fetchedAt time.Time
+}
+
+type valuesCacheState struct {
+	ttl     time.Duration
+	entries map[string]valuesEntry
+}
+
+// 🗂️ VALUES CACHE
+
+// Default of [SetValuesCacheTTL]
+const DefaultValuesCacheTTL = time.Hour
+
+var valuesCache = mutexStruct[valuesCacheState]{
+	value: valuesCacheState{ttl: DefaultValuesCacheTTL, entries: map[string]valuesEntry{}},
+}
+
+// How long [FetchValues] results are reused before calling the API again.
+// Default [DefaultValuesCacheTTL], 0 disables the cache.
+func SetValuesCacheTTL(d time.Duration) {
+	valuesCache.Lock()
+	defer valuesCache.Unlock()
+	valuesCache.value.ttl = d
+	if d <= 0 {
+		clear(valuesCache.value.entries)
+	}
+}
+
+// Empty the values cache and restore [DefaultValuesCacheTTL]
+func resetValuesCache() {
+	valuesCache.Lock()
+	defer valuesCache.Unlock()
+	valuesCache.value.ttl = DefaultValuesCacheTTL
+	clear(valuesCache.value.entries)
+}
+
+func cachedValues(key string) ([]string, bool) {
+	valuesCache.RLock()
+	defer valuesCache.RUnlock()
+	entry, ok := valuesCache.value.entries[key]
+	if !ok || time.Since(entry.fetchedAt) >= valuesCache.value.ttl {
+		return nil, false
+	}
+	return entry.values, true
+}
+
+func storeValues(key string, values []string) {
+	valuesCache.Lock()
+	defer valuesCache.Unlock()
+	if valuesCache.value.ttl > 0 {
+		valuesCache.value.entries[key] = valuesEntry{values, time.Now()}
+	}
+}
+
+// Current values of a property from the API, one of [constants.ValueKeys].
+// Other keys are rejected without calling the API.
+// The request is sent like the others: bounded by ctx, with the API key,
+// and an error status returns an [APIError], matching [ErrRateLimited] for a 429.
+//
+// Usage:
+//
+//	currencies, err := FetchValues(ctx, constants.ValueKeyCurrency)
+func FetchValues(ctx context.Context, key string) ([]string, error) {
+	if !slices.Contains(valueKeys, key) {
+		return nil, fmt.Errorf("unknown values key %q, expected one of %v", key, valueKeys)
+	}
+	if values, ok := cachedValues(key); ok {
+		return slices.Clone(values), nil
+	}
+	return fetchValues(ctx, key)
+}
+
+// FetchValues bypassing the values cache, storing the result in it
+func fetchValues(ctx context.Context, key string) ([]string, error) {
+	body, _, err := send(ctx, "GET", APIEndpoints().Values+key, nil)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Values []string `json:"values"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	storeValues(key, slices.Clone(res.Values))
+	return res.Values, nil
+}
+
+// Current values of every property, fetched concurrently.
+// Keys that fail are missing from the map and their errors are joined.
+func FetchAllValues(ctx context.Context) (map[string][]string, error) {
+	res := make(map[string][]string, len(valueKeys))
+	errs := make([]error, len(valueKeys))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, key := range valueKeys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values, err := FetchValues(ctx, key)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", key, err)
+				return
+			}
+			mu.Lock()
+			res[key] = values
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return res, errors.Join(errs...)
+}
+
+// Re-fetch every property into the values cache, ignoring cached entries,
+// e.g. on a schedule. Keys are fetched concurrently, each under its own
+// timeout derived from ctx (0 for none). A failing key keeps its cached
+// values and does not stop the others: refreshed lists the keys that
+// succeeded, in [FetchAllValues] order, and err joins the failures.
+//
+// Usage:
+//
+//	refreshed, err := RefreshValueSets(ctx, 10*time.Second)
+func RefreshValueSets(ctx context.Context, timeout time.Duration) (refreshed []string, err error) {
+	errs := make([]error, len(valueKeys))
+
+	var wg sync.WaitGroup
+	for i, key := range valueKeys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var keyCtx context.Context
+			var cancel context.CancelFunc
+			if timeout > 0 {
+				keyCtx, cancel = context.WithTimeout(ctx, timeout)
+			} else {
+				keyCtx, cancel = context.WithCancel(ctx)
+			}
+			defer cancel()
+			if _, err := fetchValues(keyCtx, key); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", key, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, key := range valueKeys {
+		if errs[i] == nil {
+			refreshed = append(refreshed, key)
+		}
+	}
+	return refreshed, errors.Join(errs...)
+}
@@ -2,14 +2,25 @@ package openfigi
 
 import (
 	"bytes"
+	"cmp"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
+	"mime"
 	"net/http"
+	"net/url"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
+	"unicode"
 
+	"github.com/minh-dng/openfigi-go/constants"
 	"golang.org/x/exp/constraints"
 )
 
@@ -19,10 +30,44 @@ type mutexStruct[T any] struct {
 	value T
 }
 
+// 📸 SNAPSHOT
+
+// Held for writing while setting the base URL, API key, client or logger, and for
+// reading by [configSnapshot], so that a request never mixes old and new values,
+// e.g. a new base URL with an old key during [Configure]
+var requestConfig sync.RWMutex
+
+// Settings read together by a request
+type config struct {
+	baseURL string
+	apiKey  string
+	client  Doer
+	logger  *slog.Logger
+}
+
+func configSnapshot() config {
+	requestConfig.RLock()
+	defer requestConfig.RUnlock()
+	return config{
+		baseURL: APIBaseUrl(),
+		apiKey:  APIKey(),
+		client:  httpClient(),
+		logger:  logger(),
+	}
+}
+
 // 🔗 BaseURL
 var apiUrl mutexStruct[string]
 
+const DefaultAPIBaseUrl = "https://api.openfigi.com/v3"
+
 func SetAPIBaseUrl(url string) {
+	requestConfig.Lock()
+	defer requestConfig.Unlock()
+	setAPIBaseUrl(url)
+}
+
+func setAPIBaseUrl(url string) {
 	apiUrl.Lock()
 	defer apiUrl.Unlock()
 	apiUrl.value = url
@@ -34,13 +79,69 @@ func APIBaseUrl() string {
 	return apiUrl.value
 }
 
+// 🛣️ ENDPOINTS
+
+// Endpoint paths, appended to the base URL
+type Endpoints struct {
+	Mapping string // Default "/mapping"
+	Search  string // Default "/search"
+	Filter  string // Default "/filter"
+	Values  string // Default "/mapping/values/", followed by the property
+}
+
+var DefaultEndpoints = Endpoints{
+	Mapping: "/mapping",
+	Search:  "/search",
+	Filter:  "/filter",
+	Values:  "/mapping/values/",
+}
+
+var apiEndpoints = mutexStruct[Endpoints]{value: DefaultEndpoints}
+
+// Override the endpoint paths, e.g. behind a gateway prefixing routes.
+// Empty paths keep their default.
+//
+// Usage:
+//
+//	SetAPIEndpoints(Endpoints{Mapping: "/openfigi/mapping", Search: "/openfigi/search"})
+func SetAPIEndpoints(endpoints Endpoints) {
+	if endpoints.Mapping == "" {
+		endpoints.Mapping = DefaultEndpoints.Mapping
+	}
+	if endpoints.Search == "" {
+		endpoints.Search = DefaultEndpoints.Search
+	}
+	if endpoints.Filter == "" {
+		endpoints.Filter = DefaultEndpoints.Filter
+	}
+	if endpoints.Values == "" {
+		endpoints.Values = DefaultEndpoints.Values
+	}
+
+	apiEndpoints.Lock()
+	defer apiEndpoints.Unlock()
+	apiEndpoints.value = endpoints
+}
+
+func APIEndpoints() Endpoints {
+	apiEndpoints.RLock()
+	defer apiEndpoints.RUnlock()
+	return apiEndpoints.value
+}
+
 // 🔒 AUTH
 var apiKey mutexStruct[string]
 
-func SetAPIKey(url string) {
+func SetAPIKey(key string) {
+	requestConfig.Lock()
+	defer requestConfig.Unlock()
+	setAPIKey(key)
+}
+
+func setAPIKey(key string) {
 	apiKey.Lock()
 	defer apiKey.Unlock()
-	apiKey.value = url
+	apiKey.value = key
 }
 
 func APIKey() string {
@@ -49,6 +150,463 @@ func APIKey() string {
 	return apiKey.value
 }
 
+// 🌐 HTTP CLIENT
+
+// Sends the requests, as *http.Client does
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+var client mutexStruct[Doer]
+
+// Client sending the requests, e.g. with a custom transport or proxy.
+// Default nil, using [http.DefaultClient].
+func SetHTTPClient(c *http.Client) {
+	SetDoer(doerOf(c))
+}
+
+// c as a Doer, nil rather than an interface holding a nil pointer
+func doerOf(c *http.Client) Doer {
+	if c == nil {
+		return nil
+	}
+	return c
+}
+
+// Send the requests with d instead of an *http.Client, e.g. a stub answering
+// canned responses in the tests of code calling this package, without a server.
+// Replaces the client of [SetHTTPClient]. nil restores [http.DefaultClient].
+//
+// Usage:
+//
+//	type stub struct{}
+//
+//	func (stub) Do(req *http.Request) (*http.Response, error) {
+//		return &http.Response{
+//			StatusCode: http.StatusOK,
+//			Header:     http.Header{"Content-Type": {"application/json"}},
+//			Body:       io.NopCloser(strings.NewReader(`[{"data": []}]`)),
+//		}, nil
+//	}
+//
+//	SetDoer(stub{})
+func SetDoer(d Doer) {
+	requestConfig.Lock()
+	defer requestConfig.Unlock()
+	setDoer(d)
+}
+
+func setDoer(d Doer) {
+	client.Lock()
+	defer client.Unlock()
+	client.value = d
+}
+
+func httpClient() Doer {
+	client.RLock()
+	defer client.RUnlock()
+	if client.value == nil {
+		return http.DefaultClient
+	}
+	return client.value
+}
+
+// 📨 HEADERS
+var defaultHeaders mutexStruct[http.Header]
+
+// Headers added to every request, e.g. for a gateway. They override the
+// `Accept: application/json` and `Content-Type` headers set by the package,
+// but not the API key. nil, the default, adds none.
+//
+// Usage:
+//
+//	SetDefaultHeaders(http.Header{"Accept": {"application/json, */*"}})
+func SetDefaultHeaders(header http.Header) {
+	defaultHeaders.Lock()
+	defer defaultHeaders.Unlock()
+	defaultHeaders.value = header.Clone()
+}
+
+func DefaultHeaders() http.Header {
+	defaultHeaders.RLock()
+	defer defaultHeaders.RUnlock()
+	return defaultHeaders.value.Clone()
+}
+
+// Transforms every request just before it is sent, e.g. to sign it.
+// An error aborts the call and is returned as is.
+type RequestMutator func(*http.Request) error
+
+var requestMutator mutexStruct[RequestMutator]
+
+// Mutator applied to every request, retries included, after all the headers
+// are set, the API key and [DefaultHeaders] included, so it can read or
+// override them. nil, the default, disables it.
+//
+// Usage:
+//
+//	SetRequestMutator(func(req *http.Request) error {
+//		req.Header.Set("X-Signature", sign(req))
+//		return nil
+//	})
+func SetRequestMutator(mutate RequestMutator) {
+	requestMutator.Lock()
+	defer requestMutator.Unlock()
+	requestMutator.value = mutate
+}
+
+func getRequestMutator() RequestMutator {
+	requestMutator.RLock()
+	defer requestMutator.RUnlock()
+	return requestMutator.value
+}
+
+// ⏱️ TIMEOUT
+var timeout mutexStruct[time.Duration]
+
+// Timeout of the methods without a context: [MappingRequest.Fetch],
+// [BaseItem.Search], [BaseItem.Filter] and Next(). Retries count towards it.
+// Default 0, no timeout. Methods taking a context use its deadline instead.
+func SetTimeout(d time.Duration) {
+	timeout.Lock()
+	defer timeout.Unlock()
+	timeout.value = d
+}
+
+func Timeout() time.Duration {
+	timeout.RLock()
+	defer timeout.RUnlock()
+	return timeout.value
+}
+
+// Context for the methods without one, bounded by [Timeout]
+func defaultContext() (context.Context, context.CancelFunc) {
+	if d := Timeout(); d > 0 {
+		return context.WithTimeout(context.Background(), d)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// 📝 LOGGING
+var logBodies mutexStruct[bool]
+
+// Include response bodies in log lines and [APIError.Body]. Default false,
+// keeping identifiers out of logs; the request line is always logged.
+func SetLogBodies(enable bool) {
+	logBodies.Lock()
+	defer logBodies.Unlock()
+	logBodies.value = enable
+}
+
+func LogBodies() bool {
+	logBodies.RLock()
+	defer logBodies.RUnlock()
+	return logBodies.value
+}
+
+var logValidation mutexStruct[bool]
+
+// Log failed Build() calls at debug level, with the offending field and value.
+// Default false.
+func SetLogValidation(enable bool) {
+	logValidation.Lock()
+	defer logValidation.Unlock()
+	logValidation.value = enable
+}
+
+func LogValidation() bool {
+	logValidation.RLock()
+	defer logValidation.RUnlock()
+	return logValidation.value
+}
+
+var warnFIGIOnly mutexStruct[bool]
+
+// Log mapping responses with FIGI-only jobs (see
+// [SingleMappingResponse.PartialFIGIOnly]) at warn level instead of debug.
+// Default false, as keyless or unentitled callers get them on every fetch.
+func SetWarnFIGIOnly(enable bool) {
+	warnFIGIOnly.Lock()
+	defer warnFIGIOnly.Unlock()
+	warnFIGIOnly.value = enable
+}
+
+func WarnFIGIOnly() bool {
+	warnFIGIOnly.RLock()
+	defer warnFIGIOnly.RUnlock()
+	return warnFIGIOnly.value
+}
+
+var pkgLogger mutexStruct[*slog.Logger]
+
+// Logger of the request lines and API errors. Default nil, using [slog.Default].
+func SetLogger(l *slog.Logger) {
+	requestConfig.Lock()
+	defer requestConfig.Unlock()
+	pkgLogger.Lock()
+	defer pkgLogger.Unlock()
+	pkgLogger.value = l
+}
+
+func logger() *slog.Logger {
+	pkgLogger.RLock()
+	defer pkgLogger.RUnlock()
+	if pkgLogger.value == nil {
+		return slog.Default()
+	}
+	return pkgLogger.value
+}
+
+// 🧪 DECODING
+var strictDecode, keepRaw mutexStruct[bool]
+
+// Error on response fields unknown to this package, e.g. a field OpenFIGI
+// added to [FIGIObject]. Default false, dropping them silently.
+// Meant for development, to notice API schema changes early.
+func SetStrictDecode(enable bool) {
+	strictDecode.Lock()
+	defer strictDecode.Unlock()
+	strictDecode.value = enable
+}
+
+func StrictDecode() bool {
+	strictDecode.RLock()
+	defer strictDecode.RUnlock()
+	return strictDecode.value
+}
+
+// Keep the undecoded payload in the Raw field of responses. Default false.
+func SetKeepRaw(enable bool) {
+	keepRaw.Lock()
+	defer keepRaw.Unlock()
+	keepRaw.value = enable
+}
+
+func KeepRaw() bool {
+	keepRaw.RLock()
+	defer keepRaw.RUnlock()
+	return keepRaw.value
+}
+
+// 🧐 STRICT VALIDATION
+var strictValidation mutexStruct[bool]
+
+// Reject items the API accepts but that are likely mistakes, e.g. a
+// `marketSecDes` contradicting `securityType2`, or a `stateCode` outside
+// municipal securities. Default false, as these checks
+// may block legitimate edge cases.
+func SetStrictValidation(enable bool) {
+	strictValidation.Lock()
+	defer strictValidation.Unlock()
+	strictValidation.value = enable
+}
+
+func StrictValidation() bool {
+	strictValidation.RLock()
+	defer strictValidation.RUnlock()
+	return strictValidation.value
+}
+
+// 🧼 QUERY SANITIZING
+var sanitizeQueries mutexStruct[bool]
+
+// Apply [SanitizeQuery] to the query of every search and filter. Default false.
+func SetSanitizeQueries(enable bool) {
+	sanitizeQueries.Lock()
+	defer sanitizeQueries.Unlock()
+	sanitizeQueries.value = enable
+}
+
+func SanitizeQueries() bool {
+	sanitizeQueries.RLock()
+	defer sanitizeQueries.RUnlock()
+	return sanitizeQueries.value
+}
+
+// 🔢 IDVALUE COERCION
+var coerceIDValue mutexStruct[bool]
+
+// Accept an integer `idValue` for every idType. It is sent as a decimal string,
+// e.g. 123 as "123", except for the idTypes in [NumericIDTypes] which take
+// numbers. Default false, rejecting integers for the other idTypes.
+func SetCoerceIDValue(enable bool) {
+	coerceIDValue.Lock()
+	defer coerceIDValue.Unlock()
+	coerceIDValue.value = enable
+}
+
+func CoerceIDValue() bool {
+	coerceIDValue.RLock()
+	defer coerceIDValue.RUnlock()
+	return coerceIDValue.value
+}
+
+// 🔣 NUMBER FORMAT
+var fixedDecimalBounds mutexStruct[bool]
+
+// Send the numeric bounds of `strike`, `contractSize` and `coupon` in fixed
+// decimal notation, e.g. 0.0000001 instead of 1e-7, for magnitudes below 1e21.
+// Default false, using encoding/json, which only uses fixed notation between 1e-6 and 1e21.
+func SetFixedDecimalBounds(enable bool) {
+	fixedDecimalBounds.Lock()
+	defer fixedDecimalBounds.Unlock()
+	fixedDecimalBounds.value = enable
+}
+
+func FixedDecimalBounds() bool {
+	fixedDecimalBounds.RLock()
+	defer fixedDecimalBounds.RUnlock()
+	return fixedDecimalBounds.value
+}
+
+// ✅ FETCH VALIDATION
+var validateBeforeFetch = mutexStruct[bool]{value: true}
+
+// Validate every item of a mapping request before sending it, in
+// [MappingRequest.Fetch], [MappingRequest.FetchAll] and the like, returning
+// the errors of the invalid items by index instead of the API's 400 for the
+// whole request. Default true; disable to let the API judge items built
+// without the builders.
+//
+// This changes earlier releases, which sent every item as is: a request the
+// API accepted may now fail locally, e.g. an unknown enum value the API has
+// since added and the generated constants lack. Integer `idValue`s decoded
+// from JSON as float64 or [json.Number] are accepted.
+func SetValidateBeforeFetch(enable bool) {
+	validateBeforeFetch.Lock()
+	defer validateBeforeFetch.Unlock()
+	validateBeforeFetch.value = enable
+}
+
+func ValidateBeforeFetch() bool {
+	validateBeforeFetch.RLock()
+	defer validateBeforeFetch.RUnlock()
+	return validateBeforeFetch.value
+}
+
+// 📈 METRICS
+
+// Called after every HTTP round trip, retries included.
+// statusCode is 0 when err is a transport error.
+type MetricFunc func(endpoint string, statusCode int, latency time.Duration, err error)
+
+var onMetric mutexStruct[MetricFunc]
+
+// Hook for observability, e.g. Prometheus counters and histograms.
+// It runs on the request path, so it must be fast and non-blocking. nil disables it.
+//
+// Usage:
+//
+//	SetOnMetric(func(endpoint string, statusCode int, latency time.Duration, err error) {
+//		requests.WithLabelValues(endpoint, strconv.Itoa(statusCode)).Observe(latency.Seconds())
+//	})
+func SetOnMetric(f MetricFunc) {
+	onMetric.Lock()
+	defer onMetric.Unlock()
+	onMetric.value = f
+}
+
+func emitMetric(endpoint string, statusCode int, latency time.Duration, err error) {
+	onMetric.RLock()
+	f := onMetric.value
+	onMetric.RUnlock()
+	if f != nil {
+		f(endpoint, statusCode, latency, err)
+	}
+}
+
+// 🧹 RESET
+
+// Restore every package setting to its default, e.g. between tests:
+// the base URL and endpoints, and no API key, client, logger, cache, hooks or opt-in behavior.
+// The values cache is emptied too.
+//
+// Usage:
+//
+//	t.Cleanup(ResetConfig)
+func ResetConfig() {
+	SetAPIBaseUrl(DefaultAPIBaseUrl)
+	SetAPIEndpoints(DefaultEndpoints)
+	SetAPIKey("")
+	SetHTTPClient(nil)
+	SetDefaultHeaders(nil)
+	SetRequestMutator(nil)
+	SetTimeout(0)
+	SetLogBodies(false)
+	SetLogValidation(false)
+	SetWarnFIGIOnly(false)
+	SetLogger(nil)
+	SetStrictDecode(false)
+	SetKeepRaw(false)
+	SetStrictValidation(false)
+	SetSanitizeQueries(false)
+	SetCoerceIDValue(false)
+	SetFixedDecimalBounds(false)
+	SetValidateBeforeFetch(true)
+	SetOnMetric(nil)
+	SetMaxRetries(0)
+	SetCache(nil, 0)
+	SetSharedRateLimiter(nil)
+	resetValuesCache()
+	setLastRateLimit(parseRateLimit(nil)) // No headers seen
+}
+
+// ⚙️ CONFIGURE
+
+// Settings applied together by [Configure]. Zero values restore the defaults.
+type Config struct {
+	BaseURL            string       // Default [DefaultAPIBaseUrl]
+	APIKey             string       // Default none
+	HTTPClient         *http.Client // Default [http.DefaultClient]
+	RateLimitPerMinute int          // Requests sent at most a minute, evenly spaced. Default 0, unlimited
+	MaxRetries         int          // See [SetMaxRetries]. Default 0
+}
+
+// Check the settings without applying them
+func (cfg Config) Validate() error {
+	if cfg.BaseURL != "" {
+		u, err := url.Parse(cfg.BaseURL)
+		if err != nil {
+			return fmt.Errorf("bad BaseURL: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("bad BaseURL %q: expected an absolute http(s) URL", cfg.BaseURL)
+		}
+	}
+	if cfg.RateLimitPerMinute < 0 {
+		return fmt.Errorf("RateLimitPerMinute must not be negative, got %d", cfg.RateLimitPerMinute)
+	}
+	if cfg.MaxRetries < 0 {
+		return fmt.Errorf("MaxRetries must not be negative, got %d", cfg.MaxRetries)
+	}
+	return nil
+}
+
+// Apply every setting of cfg at once, e.g. from environment variables.
+// Nothing is applied if cfg is invalid. A rate limit replaces the
+// [SetSharedRateLimiter] limiter, and no rate limit removes it.
+//
+// Usage:
+//
+//	err := Configure(Config{APIKey: os.Getenv("OPENFIGI_API_KEY"), RateLimitPerMinute: 25, MaxRetries: 3})
+func Configure(cfg Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	requestConfig.Lock()
+	setAPIBaseUrl(cmp.Or(cfg.BaseURL, DefaultAPIBaseUrl))
+	setAPIKey(cfg.APIKey)
+	setDoer(doerOf(cfg.HTTPClient))
+	requestConfig.Unlock()
+	var limiter RateLimiter
+	if cfg.RateLimitPerMinute > 0 {
+		limiter = newIntervalLimiter(cfg.RateLimitPerMinute)
+	}
+	SetSharedRateLimiter(limiter)
+	SetMaxRetries(cfg.MaxRetries)
+	return nil
+}
+
 // ========================= TYPEs =========================
 
 type interval[T constraints.Ordered] [2]T
@@ -110,6 +668,9 @@ type BaseItem struct {
 	// State code.
 	// See https://api.openfigi.com/v3/mapping/values/stateCode
 	StateCode string `json:"stateCode,omitempty"`
+	// Search query used by [BaseItem.SearchBuilt] and [BaseItem.FilterBuilt].
+	// Not part of the mapping payload.
+	Query string `json:"-"`
 }
 
 // Usage:
@@ -152,9 +713,28 @@ func (item *BaseItem) validate() error {
 		}
 	}
 
-	// Only option has expiration
-	if !(item.SecurityType2 == "Option") && item.Expiration != nil {
-		return fmt.Errorf("`expiration` is only valid for `Option`")
+	// Coupon and contract size cannot be negative, strike can
+	if err := item.Coupon.validateNonNegative("coupon"); err != nil {
+		return err
+	}
+	if err := item.ContractSize.validateNonNegative("contractSize"); err != nil {
+		return err
+	}
+
+	if item.OptionType != "" && item.OptionType != "Call" && item.OptionType != "Put" {
+		return fmt.Errorf("`optionType` must be `Call` or `Put`, got %q", item.OptionType)
+	}
+
+	// Only option has optionType, strike and expiration
+	isOption := item.isOption()
+	if !isOption && item.OptionType != "" {
+		return fmt.Errorf("`optionType` is only valid for `Option`, set `securityType2` to `Option`")
+	}
+	if !isOption && item.Strike != nil {
+		return fmt.Errorf("`strike` is only valid for `Option`, set `securityType2` to `Option`")
+	}
+	if !isOption && item.Expiration != nil {
+		return fmt.Errorf("`expiration` is only valid for `Option`, set `securityType2` to `Option`")
 	}
 
 	// Only pool has maturity
@@ -162,13 +742,48 @@ func (item *BaseItem) validate() error {
 		return fmt.Errorf("`maturity` is only valid for `Pool`")
 	}
 
+	if StrictValidation() {
+		return item.validateStrict()
+	}
+	return nil
+}
+
+// Whether the item is an option: `securityType2` `Option`, or a
+// `securityType` such as `Equity Option`
+func (item *BaseItem) isOption() bool {
+	return item.SecurityType2 == "Option" || strings.Contains(strings.ToUpper(item.SecurityType), "OPTION")
+}
+
+// Validate the item for a search with query.
+// Besides the usual checks, errors when the item has no constraint and
+// query is empty, which would match every instrument.
+func (item BaseItem) ValidateForSearch(query string) error {
+	if err := item.validate(); err != nil {
+		return err
+	}
+	empty := item
+	empty.Query = ""
+	if query == "" && reflect.DeepEqual(empty, BaseItem{}) {
+		return fmt.Errorf("no constraint and empty query, set at least one field or a query")
+	}
 	return nil
 }
 
-// Convert to MappingItem, requires `idType` and `value`
-func (b_item *BaseItem) AsMappingItem(idType string, value any) (item MappingItem, err error) {
+// Copy of the item with its own intervals
+func (item BaseItem) clone() BaseItem {
+	item.Strike = clonePtr(item.Strike)
+	item.ContractSize = clonePtr(item.ContractSize)
+	item.Coupon = clonePtr(item.Coupon)
+	item.Expiration = clonePtr(item.Expiration)
+	item.Maturity = clonePtr(item.Maturity)
+	return item
+}
+
+// Convert to MappingItem, requires `idType` and `value`.
+// The intervals are copied, not shared.
+func (b_item *BaseItem) AsMappingItem(idType IDType, value any) (item MappingItem, err error) {
 	item = MappingItem{
-		BaseItem: *b_item,
+		BaseItem: b_item.clone(),
 		Type:     idType,
 		Value:    value,
 	}
@@ -176,6 +791,34 @@ func (b_item *BaseItem) AsMappingItem(idType string, value any) (item MappingIte
 	return
 }
 
+// A search fully described: the item and its query, e.g. to pass around
+// or queue. Counterpart of [BaseItem.AsMappingItem] for the search flow.
+type SearchableItem struct {
+	Item  BaseItem
+	Query string
+}
+
+// Attach a search query, used instead of the one given to
+// [BaseItemBuilder.SetQuery]. The intervals are copied, not shared.
+//
+// Usage:
+//
+//	search := item.WithQuery("IBM")
+//	res, err := search.Search(ctx)
+func (b_item *BaseItem) WithQuery(query string) SearchableItem {
+	return SearchableItem{Item: b_item.clone(), Query: query}
+}
+
+// First page of the search
+func (s SearchableItem) Search(ctx context.Context) (SearchResponse, error) {
+	return s.Item.search(ctx, s.Query, FirstPage)
+}
+
+// First page of the filter
+func (s SearchableItem) Filter(ctx context.Context) (FilterResponse, error) {
+	return s.Item.filter(ctx, s.Query, FirstPage)
+}
+
 // MappingItem
 
 type MappingItem struct {
@@ -183,15 +826,45 @@ type MappingItem struct {
 	BaseItem
 	// Type of third party identifier. See https://www.openfigi.com/api#v3-idType-values
 	// **Requirement**: For `BASE_TICKER` and `ID_EXCH_SYMBOL`, `securityType2` must be provided.
-	Type string `json:"idType"`
-	// The value for the represented third party identifier
+	// See [constants.IDTypeRequiresSecurityType2].
+	// Case-insensitive: canonicalized to uppercase, so `id_isin` is sent as `ID_ISIN`.
+	Type IDType `json:"idType"`
+	// The value for the represented third party identifier.
+	// A string, or an integer for the idTypes in [NumericIDTypes]: of an
+	// integer type, or an integral float64 or [json.Number] as decoded from JSON.
 	Value any `json:"idValue"`
 }
 
+// idTypes whose identifiers are plain numbers, accepting an integer `idValue`:
+// `ID_BB`, `ID_COMMON` and `ID_ITALY`. Every other idType requires a string.
+var NumericIDTypes = []IDType{"ID_BB", "ID_COMMON", "ID_ITALY"}
+
+// Type of third party identifier, one of the IDTYPE_ constants.
+// The constants are untyped, so they can be passed as is.
+//
+// Usage:
+//
+//	builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+type IDType string
+
+// Whether the idType is known to the API, in any case
+func (idType IDType) IsValid() bool {
+	return idTypeSet.Has(string(idType.Canonical()))
+}
+
+// The idType in uppercase, as the API expects it
+//
+// Usage:
+//
+//	IDType("id_isin").Canonical() // ID_ISIN
+func (idType IDType) Canonical() IDType {
+	return IDType(strings.ToUpper(string(idType)))
+}
+
 // Usage:
 //
 //	builder := MappingItem{}.GetBuilder()
-func (MappingItem) GetBuilder(idType string, value any) MappingItemBuilder {
+func (MappingItem) GetBuilder(idType IDType, value any) MappingItemBuilder {
 	return MappingItemBuilder{
 		BaseItemBuilder: BaseItem{}.GetBuilder(),
 		item: MappingItem{
@@ -202,29 +875,73 @@ func (MappingItem) GetBuilder(idType string, value any) MappingItemBuilder {
 }
 
 func (item *MappingItem) validate() error {
+	item.Type = item.Type.Canonical()
 	if err := item.BaseItem.validate(); err != nil {
 		return err
 	}
 
-	if !idTypeSet.Has(item.Type) {
-		return fmt.Errorf("bad `idType`. See: %s", valuesUrl(item.Type))
+	if !item.Type.IsValid() {
+		return fmt.Errorf("bad `idType`. See: %s", valuesUrl("idType"))
 	}
 
-	if (item.Type == "BASE_TICKER" || item.Type == "ID_EXCH_SYMBOL") &&
-		item.SecurityType2 == "" {
-		return fmt.Errorf("`securityType2` must be provided for `BASE_TICKER` and `ID_EXCH_SYMBOL`")
+	if constants.IDTypeRequiresSecurityType2(string(item.Type)) && item.SecurityType2 == "" {
+		return fmt.Errorf("`securityType2` must be provided for `%s`", item.Type)
 	}
 
+	if _, ok := item.Value.(string); ok {
+		return nil
+	}
+	if _, ok := integerString(item.Value); !ok {
+		return fmt.Errorf("`idValue` must be a string or an integer, got %T", item.Value)
+	}
+	if !slices.Contains(NumericIDTypes, item.Type) && !CoerceIDValue() {
+		return fmt.Errorf("`idValue` must be a string for `%s`, got %T", item.Type, item.Value)
+	}
 	return nil
 }
 
-// Convert to BaseItem
+// Decimal digits of v if it is an integer: of an integer type, an integral
+// float64 as decoded from JSON into an any, or a [json.Number] holding an integer
+func integerString(v any) (string, bool) {
+	switch v := v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v), true
+	case float64:
+		if v != math.Trunc(v) || math.IsInf(v, 0) {
+			return "", false
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		if _, err := strconv.ParseInt(string(v), 10, 64); err != nil {
+			return "", false
+		}
+		return string(v), true
+	}
+	return "", false
+}
+
+// Convert to BaseItem.
+// The intervals are copied, not shared.
 func (m_item *MappingItem) AsBaseItem() (item BaseItem, err error) {
-	item = m_item.BaseItem
+	item = m_item.BaseItem.clone()
 	err = item.validate()
 	return
 }
 
+// Sends the canonical idType, and an integer `idValue` as a string under
+// [CoerceIDValue] unless the idType is in [NumericIDTypes]
+func (item MappingItem) MarshalJSON() ([]byte, error) {
+	type plain MappingItem // Without this method
+	p := plain(item)
+	p.Type = item.Type.Canonical()
+	if CoerceIDValue() && !slices.Contains(NumericIDTypes, p.Type) {
+		if digits, ok := integerString(item.Value); ok {
+			p.Value = digits
+		}
+	}
+	return json.Marshal(p)
+}
+
 type MappingRequest []MappingItem
 
 // Helper to create a MappingRequest from MappingItemBuilders
@@ -265,18 +982,103 @@ type FIGIObject struct {
 	Metadata            string `json:"metadata,omitempty"` // Exists when API is unable to show non-FIGI fields
 }
 
+// Market sector of a [FIGIObject]
+type MarketSector string
+
+const (
+	MarketSectorComdty MarketSector = "Comdty"
+	MarketSectorCorp   MarketSector = "Corp"
+	MarketSectorCurncy MarketSector = "Curncy"
+	MarketSectorEquity MarketSector = "Equity"
+	MarketSectorGovt   MarketSector = "Govt"
+	MarketSectorIndex  MarketSector = "Index"
+	MarketSectorMMkt   MarketSector = "M-Mkt"
+	MarketSectorMtge   MarketSector = "Mtge"
+	MarketSectorMuni   MarketSector = "Muni"
+	MarketSectorPfd    MarketSector = "Pfd"
+)
+
+// Security type of a [FIGIObject], e.g. [constants.SECURITYTYPE_CommonStock].
+// Read typed with [FIGIObject.SecurityTypeTyped].
+type SecurityType string
+
+// Whether both objects are the same instrument, compared on:
+//  1. FIGI, when both have one;
+//  2. else UniqueID, when both have one;
+//  3. else every field.
+//
+// Usage:
+//
+//	if a.Equal(b) { ... }
+func (o FIGIObject) Equal(other FIGIObject) bool {
+	switch {
+	case o.FIGI != "" && other.FIGI != "":
+		return o.FIGI == other.FIGI
+	case o.UniqueID != "" && other.UniqueID != "":
+		return o.UniqueID == other.UniqueID
+	}
+	return o == other
+}
+
+// Typed market sector
+//
+// Usage:
+//
+//	if obj.Sector() == MarketSectorEquity { ... }
+func (o FIGIObject) Sector() MarketSector {
+	return MarketSector(o.MarketSector)
+}
+
+// Typed security type, named after the field it reads as the typed
+// setters of [BaseItemBuilder] are
+//
+// Usage:
+//
+//	if obj.SecurityTypeTyped() == constants.SECURITYTYPE_CommonStock { ... }
+func (o FIGIObject) SecurityTypeTyped() SecurityType {
+	return SecurityType(o.SecurityType)
+}
+
 type SingleMappingResponse struct {
 	Data    []FIGIObject `json:"data"`
 	Error   string       `json:"error,omitempty"`
 	Warning []string     `json:"warning,omitempty"`
+	// This job's undecoded response, set under [KeepRaw]
+	Raw json.RawMessage `json:"-"`
+}
+
+// Whether the job mapped to FIGIs only: every object carries `metadata` and
+// its non-FIGI fields, e.g. name and ticker, are blank. The API does so when
+// it cannot show those fields, e.g. without the needed entitlement.
+func (res SingleMappingResponse) PartialFIGIOnly() bool {
+	if len(res.Data) == 0 {
+		return false
+	}
+	for _, obj := range res.Data {
+		if obj.Metadata == "" {
+			return false
+		}
+	}
+	return true
 }
 
 type SearchResponse struct {
-	Data     []FIGIObject `json:"data"`
-	Error    string       `json:"error,omitempty"`
-	NextHash string       `json:"next,omitempty"`
-	baseitem BaseItem     // For Next() calls
-	query    string       // For Next() calls
+	Data  []FIGIObject `json:"data"`
+	Error string       `json:"error,omitempty"`
+	// Cursor of the next page, the `start` of the next call.
+	// Empty on the last page.
+	NextHash string `json:"next,omitempty"`
+	// The undecoded page, set under [KeepRaw]
+	Raw      json.RawMessage `json:"-"`
+	baseitem BaseItem        // For Next() calls
+	query    string          // For Next() calls
+	start    string          // Cursor this page was fetched with
+	endpoint string          // Path the page was fetched from
+	header   http.Header     // Response headers
+	// Largest page before this one when paginating, see [SearchResponse.PageSize]
+	largestPage int
+	// Number of pages before this one when paginating
+	pagesBefore int
 }
 
 type FilterResponse struct {
@@ -286,15 +1088,116 @@ type FilterResponse struct {
 
 // ========================= API =========================
 
-type searchOrFilterRequest struct {
+// `start` of the first page. The API starts from the beginning when `start`
+// is omitted, so this is the empty string; pass [SearchResponse.NextHash]
+// (or use Next()) for the following pages.
+//
+// Usage:
+//
+//	res, err := item.Search("CRYP", FirstPage)
+const FirstPage = ""
+
+// Body of a search or filter request, for full control over it, e.g. to
+// resume from a saved cursor. Build it with [NewSearchRequest] and send it
+// with [SearchRequest.Do]; [BaseItem.Search] and [BaseItem.Filter] build it
+// for you.
+type SearchRequest struct {
 	BaseItem
 	Query string `json:"query,omitempty"`
+	// Cursor of the page to fetch, the `next` of the previous page, or [FirstPage]
 	Start string `json:"start,omitempty"`
 }
 
+// === Payloads
+
+// The JSON body sent by [MappingRequest.Fetch]
+func (m_req MappingRequest) MarshalRequest() ([]byte, error) {
+	return json.Marshal(m_req)
+}
+
+// The JSON body sent by [BaseItem.Search] and [BaseItem.Filter]
+//
+// Usage:
+//
+//	payload, _ := item.MarshalRequest("CRYP", "")
+//	fmt.Println(string(payload)) // curl -d "$payload" ...
+func (item BaseItem) MarshalRequest(query string, start string) ([]byte, error) {
+	if SanitizeQueries() {
+		query = SanitizeQuery(query)
+	}
+	return json.Marshal(SearchRequest{
+		BaseItem: item,
+		Query:    query,
+		Start:    start,
+	})
+}
+
+// Keys of the interval fields in the JSON body
+var intervalKeys = []string{"strike", "contractSize", "coupon", "expiration", "maturity"}
+
+// Check the JSON body of item is in the wire format the API expects:
+// valid JSON, without `Inf` or `NaN`, and intervals as two bounds,
+// each a number, a date or `null` when open.
+//
+// Usage:
+//
+//	if err := ValidateRequestJSON(item); err != nil {
+//		log.Fatal(err)
+//	}
+func ValidateRequestJSON(item BaseItem) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("invalid JSON %s: %w", data, err)
+	}
+	for _, key := range intervalKeys {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		bounds, ok := value.([]any)
+		if !ok || len(bounds) != 2 {
+			return fmt.Errorf("`%s` must be 2 bounds, got %v", key, value)
+		}
+		for _, bound := range bounds {
+			switch v := bound.(type) {
+			case nil, float64:
+			case string:
+				if v == "" {
+					return fmt.Errorf("`%s` open bound must be null, got \"\"", key)
+				}
+			default:
+				return fmt.Errorf("`%s` bound must be a number, a string or null, got %v", key, bound)
+			}
+		}
+	}
+	return nil
+}
+
+// Clean a search query: control characters (tabs, newlines...) become spaces,
+// whitespace runs collapse into one space and leading and trailing spaces are
+// trimmed. Nothing else is changed.
+//
+// Usage:
+//
+//	SanitizeQuery("  IBM\n CORP ") // "IBM CORP"
+func SanitizeQuery(query string) string {
+	query = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, query)
+	return strings.Join(strings.Fields(query), " ")
+}
+
 // === Calls
 
-// Fetch the mappings
+// Fetch the mappings. Under [ValidateBeforeFetch], the default, invalid
+// items are reported by index without sending the request.
 //
 // Usage:
 //
@@ -308,64 +1211,228 @@ type searchOrFilterRequest struct {
 //	}
 //	res, err := req.Fetch()
 func (m_req MappingRequest) Fetch() (res []SingleMappingResponse, err error) {
-	jsonData, err := json.Marshal(m_req)
-	if err != nil {
+	if err = m_req.prevalidate(); err != nil {
 		return
 	}
-	req, _ := http.NewRequest("POST", APIBaseUrl()+"/mapping", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	if key := APIKey(); key != "" {
-		req.Header.Set("X-OPENFIGI-APIKEY", key)
+	ctx, cancel := defaultContext()
+	defer cancel()
+	return m_req.fetch(ctx)
+}
+
+func (m_req MappingRequest) fetch(ctx context.Context) (res []SingleMappingResponse, err error) {
+	if len(m_req) == 0 {
+		return nil, ErrEmptyRequest
+	}
+	jsonData, err := m_req.MarshalRequest()
+	if err != nil {
+		return
 	}
-	slog.Debug(fmt.Sprintf("POST %s", APIBaseUrl()+"/mapping"))
 
-	resp, err := http.DefaultClient.Do(req)
+	body, _, err := send(ctx, "POST", APIEndpoints().Mapping, jsonData)
 	if err != nil {
 		return
-	} else if details, ok := httpStatusMap[resp.StatusCode]; ok {
-		slog.Error(fmt.Sprintf("%d — %s", resp.StatusCode, details))
-		err = fmt.Errorf("%d", resp.StatusCode)
+	}
+	if res, err = decodeMappingResponses(body); err != nil {
 		return
 	}
-	defer resp.Body.Close()
-
-	body, _ := io.ReadAll(resp.Body)
-	err = json.Unmarshal(body, &res)
+	partial := 0
+	for _, job := range res {
+		if job.PartialFIGIOnly() {
+			partial++
+		}
+	}
+	if partial > 0 {
+		level := slog.LevelDebug
+		if WarnFIGIOnly() {
+			level = slog.LevelWarn
+		}
+		logger().Log(ctx, level, fmt.Sprintf("%d of %d mapping jobs returned FIGIs only, without non-FIGI fields such as name and ticker", partial, len(res)))
+	}
 	return
 }
 
-// Search and Filter common code
-func postBaseItem[T any](endpoint string, item BaseItem, query string, start string) (res T, err error) {
-	jsonData, err := json.Marshal(searchOrFilterRequest{
-		BaseItem: item,
-		Query:    query,
-		Start:    start,
-	})
+// Every FIGI under a composite FIGI, i.e. the instrument on each of its venues.
+// Maps with the `COMPOSITE_ID_BB_GLOBAL` idType.
+//
+// Usage:
+//
+//	res, _ := MappingRequest{ibm}.Fetch()
+//	figis, err := MapCompositeFIGI(ctx, res[0].Data[0].CompositeFIGI)
+func MapCompositeFIGI(ctx context.Context, compositeFIGI string) ([]FIGIObject, error) {
+	return MapOne(ctx, "COMPOSITE_ID_BB_GLOBAL", compositeFIGI, BaseItem{})
+}
+
+// Map a single identifier under the constraints of item, which may be empty.
+// No venue is required: a `marketSecDes` alone narrows the mapping to a sector.
+// The job's error, e.g. "Invalid idType", is returned as a [ResponseError].
+//
+// Usage:
+//
+//	objs, err := MapOne(ctx, constants.IDTYPE_TICKER, "IBM", BaseItem{MarketSecDes: constants.MARKETSECDES_Equity})
+func MapOne(ctx context.Context, idType IDType, value any, item BaseItem) ([]FIGIObject, error) {
+	m_item, err := item.AsMappingItem(idType, value)
 	if err != nil {
-		return
+		return nil, err
+	}
+	res, err := MappingRequest{m_item}.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 {
+		return nil, fmt.Errorf("expected 1 mapping response, got %d", len(res))
 	}
-	req, _ := http.NewRequest("POST", APIBaseUrl()+endpoint, bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	if key := APIKey(); key != "" {
-		req.Header.Set("X-OPENFIGI-APIKEY", key)
+	if res[0].Error != "" {
+		return nil, &ResponseError{res[0].Error}
 	}
-	slog.Debug(fmt.Sprintf("POST %s", APIBaseUrl()+endpoint))
+	return res[0].Data, nil
+}
 
-	resp, err := http.DefaultClient.Do(req)
+// Search and Filter common code
+func postBaseItem[T any](ctx context.Context, endpoint string, item BaseItem, query string, start string) (res T, err error) {
+	jsonData, err := item.MarshalRequest(query, start)
 	if err != nil {
 		return
-	} else if details, ok := httpStatusMap[resp.StatusCode]; ok {
-		slog.Error(fmt.Sprintf("%d — %s", resp.StatusCode, details))
-		err = fmt.Errorf("%d", resp.StatusCode)
-		return
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	err = json.Unmarshal(body, &res)
+	body, header, err := send(ctx, "POST", endpoint, jsonData)
+	if err != nil {
+		return
+	}
+	res, err = decodePage[T](body)
+	if h, ok := any(&res).(interface{ setHeader(http.Header) }); ok {
+		h.setHeader(header)
+	}
 	return
 }
 
+// Send the JSON payload (nil for none) to the endpoint and return the response body and headers.
+// 429 and 503 responses are retried up to [MaxRetries] times.
+// Bodies are served from and stored in the [Cache] set with [SetCache];
+// cached responses have no headers.
+func send(ctx context.Context, method string, endpoint string, payload []byte) ([]byte, http.Header, error) {
+	cfg := configSnapshot()
+	cache, ttl := getCache()
+	var cacheID string
+	if cache != nil {
+		cacheID = responseCacheKey(cfg, method, endpoint, payload)
+		if body, ok := cache.Get(cacheID); ok {
+			cfg.logger.Debug(fmt.Sprintf("%s %s (cached)", method, cfg.baseURL+endpoint))
+			return body, nil, nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := waitRateLimiter(ctx); err != nil {
+			return nil, nil, err
+		}
+		req, err := newRequest(ctx, cfg, method, endpoint, payload)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sentAt := time.Now()
+		resp, err := cfg.client.Do(req)
+		if err != nil {
+			emitMetric(endpoint, 0, time.Since(sentAt), err)
+			return nil, nil, err
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		setLastRateLimit(parseRateLimit(resp.Header))
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			if err := checkContentType(resp, body); err != nil {
+				emitMetric(endpoint, resp.StatusCode, time.Since(sentAt), err)
+				return nil, nil, err
+			}
+			emitMetric(endpoint, resp.StatusCode, time.Since(sentAt), nil)
+			if cache != nil {
+				cache.Set(cacheID, body, ttl)
+			}
+			return body, resp.Header.Clone(), nil
+		}
+		details, ok := httpStatusMap[resp.StatusCode]
+		if !ok {
+			details = http.StatusText(resp.StatusCode)
+		}
+		apiErr := statusError(req, cfg.baseURL, resp.StatusCode, details, body)
+		emitMetric(endpoint, resp.StatusCode, time.Since(sentAt), apiErr)
+		if attempt < MaxRetries() && isRetryable(resp.StatusCode) {
+			wait, ok := retryAfter(resp.Header)
+			if !ok {
+				wait = backoff(attempt)
+			}
+			cfg.logger.Debug(fmt.Sprintf("%d — retrying in %s", resp.StatusCode, wait))
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if LogBodies() {
+			cfg.logger.Error(fmt.Sprintf("%d — %s", resp.StatusCode, details), "body", string(body))
+		} else {
+			cfg.logger.Error(fmt.Sprintf("%d — %s", resp.StatusCode, details))
+		}
+		return nil, nil, apiErr
+	}
+}
+
+// Request to the endpoint with the package headers: Accept, Content-Type
+// when there is a payload, [DefaultHeaders] and the API key, then the [RequestMutator].
+// The base URL and key come from cfg, a [configSnapshot].
+func newRequest(ctx context.Context, cfg config, method string, endpoint string, payload []byte) (*http.Request, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, cfg.baseURL+endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, values := range DefaultHeaders() {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+	if cfg.apiKey != "" {
+		req.Header.Set("X-OPENFIGI-APIKEY", cfg.apiKey)
+	}
+	if mutate := getRequestMutator(); mutate != nil {
+		if err := mutate(req); err != nil {
+			return nil, err
+		}
+	}
+	cfg.logger.Debug(fmt.Sprintf("%s %s", method, cfg.baseURL+endpoint))
+	return req, nil
+}
+
+// Error if the response declares a content type other than JSON,
+// e.g. an HTML page from a proxy, or if the body does not start like JSON,
+// e.g. a maintenance page served with a 200 status
+func checkContentType(resp *http.Response, body []byte) error {
+	trimmed := bytes.TrimSpace(body)
+	maintenance := len(trimmed) > 0 && trimmed[0] != '{' && trimmed[0] != '['
+	contentType := resp.Header.Get("Content-Type")
+	jsonType := contentType == ""
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil &&
+		(mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) {
+		jsonType = true
+	}
+	if jsonType && !maintenance {
+		return nil
+	}
+	err := newAPIError(resp.StatusCode, "", body)
+	err.Maintenance = maintenance
+	if jsonType {
+		err.Message = "expected JSON, got a maintenance page" + bodySnippet(trimmed)
+	} else {
+		err.Message = fmt.Sprintf("expected JSON, got %q%s", contentType, bodySnippet(body))
+	}
+	return err
+}
+
 // Search with BaseItem, query and start
 //
 // Usage:
@@ -375,16 +1442,42 @@ func postBaseItem[T any](endpoint string, item BaseItem, query string, start str
 //	item, _ := builder.Build()
 //	res, err := item.Search("", "")
 func (item BaseItem) Search(query string, start string) (res SearchResponse, err error) {
-	res, err = postBaseItem[SearchResponse]("/search", item, query, start)
+	ctx, cancel := defaultContext()
+	defer cancel()
+	return item.search(ctx, query, start)
+}
+
+func (item BaseItem) search(ctx context.Context, query string, start string) (res SearchResponse, err error) {
+	endpoint := APIEndpoints().Search
+	res, err = postBaseItem[SearchResponse](ctx, endpoint, item, query, start)
+	res.endpoint = endpoint
 	res.baseitem = item
 	res.query = query
+	res.start = start
+	if err == nil && res.Error != "" {
+		err = &ResponseError{res.Error}
+	}
 
 	return
 }
 
+// Search with BaseItem and the query stored by [BaseItemBuilder.SetQuery]
+//
+// Usage:
+//
+//	builder := BaseItem{}.GetBuilder()
+//	builder.SetCurrency("AUD").SetQuery("CRYP")
+//	item, _ := builder.Build()
+//	res, err := item.SearchBuilt(ctx)
+func (item BaseItem) SearchBuilt(ctx context.Context) (res SearchResponse, err error) {
+	return item.search(ctx, item.Query, FirstPage)
+}
+
 // Continue searching with previous SearchResponse
 // using the "next" field of API response.
-// Returns an error if there are no more results or search error
+// Returns [ErrNoMoreResults] if there are no more results, or the search error.
+// The response is not advanced: calling Next() twice on it fetches the same
+// page twice. See [SearchResponse.NextMutable] for forward-only iteration.
 //
 // Usage:
 //
@@ -399,9 +1492,119 @@ func (item BaseItem) Search(query string, start string) (res SearchResponse, err
 //	}
 func (searchRes SearchResponse) Next() (SearchResponse, error) {
 	if searchRes.NextHash == "" {
-		return SearchResponse{}, fmt.Errorf("no more results")
+		return SearchResponse{}, ErrNoMoreResults
+	}
+	next, err := searchRes.baseitem.Search(searchRes.query, searchRes.NextHash)
+	next.largestPage = searchRes.largestPageSize()
+	next.pagesBefore = searchRes.pagesBefore + 1
+	return next, err
+}
+
+// Advance the response in place to the next page, see [SearchResponse.Next].
+// On error, including [ErrNoMoreResults], the response is left unchanged.
+//
+// Usage:
+//
+//	for res.HasMore() {
+//		if err := res.NextMutable(); err != nil {
+//			break
+//		}
+//		fmt.Println(res.Data)
+//	}
+func (searchRes *SearchResponse) NextMutable() error {
+	next, err := searchRes.Next()
+	if err != nil {
+		return err
 	}
-	return searchRes.baseitem.Search(searchRes.query, searchRes.NextHash)
+	*searchRes = next
+	return nil
+}
+
+// Headers of the HTTP response of this page, e.g. request IDs for support.
+// nil if the page was served from the [Cache].
+func (searchRes SearchResponse) Header() http.Header {
+	return searchRes.header
+}
+
+func (searchRes *SearchResponse) setHeader(header http.Header) {
+	searchRes.header = header
+}
+
+func (searchRes *SearchResponse) setRaw(raw json.RawMessage) {
+	searchRes.Raw = raw
+}
+
+// Number of objects on this page. The page size is chosen by the API and
+// may change; only the last page of a query is expected to be shorter.
+func (searchRes SearchResponse) PageSize() int {
+	return len(searchRes.Data)
+}
+
+// Largest page seen while paginating to this page, this one included,
+// i.e. the page size the API uses for this query
+func (searchRes SearchResponse) largestPageSize() int {
+	return max(searchRes.largestPage, len(searchRes.Data))
+}
+
+// Item the page was searched with. The intervals are copied, not shared.
+func (searchRes SearchResponse) Item() BaseItem {
+	return searchRes.baseitem.clone()
+}
+
+// Query the page was searched with
+func (searchRes SearchResponse) Query() string {
+	return searchRes.query
+}
+
+// Fresh copy of the response, back before its first page: the same item and
+// query, without data, cursor, headers nor pagination state, e.g. to re-run a
+// saved search from scratch. NextHash is empty, so run the first page through
+// the accessors [SearchResponse.Item] and [SearchResponse.Query].
+//
+// Usage:
+//
+//	fresh := saved.Restart()
+//	res, err := fresh.Item().Search(fresh.Query(), FirstPage)
+func (searchRes SearchResponse) Restart() SearchResponse {
+	return SearchResponse{baseitem: searchRes.Item(), query: searchRes.query, start: FirstPage, endpoint: searchRes.endpoint}
+}
+
+// Whether the page has no data
+func (searchRes SearchResponse) IsEmpty() bool {
+	return len(searchRes.Data) == 0
+}
+
+// Whether there is a next page to fetch with [SearchResponse.Next]
+//
+// Usage:
+//
+//	for res.HasMore() {
+//		if res, err = res.Next(); err != nil {
+//			break
+//		}
+//	}
+func (searchRes SearchResponse) HasMore() bool {
+	return searchRes.NextHash != ""
+}
+
+// Whether this is the last page: there is no next page, or this page is empty.
+// An empty page may still carry a next hash, so stop on Done() rather than
+// waiting for [ErrNoMoreResults], which Next() only returns without one.
+//
+// Usage:
+//
+//	for !res.Done() {
+//		if res, err = res.Next(); err != nil {
+//			break
+//		}
+//	}
+func (searchRes SearchResponse) Done() bool {
+	return searchRes.NextHash == "" || len(searchRes.Data) == 0
+}
+
+// Whether the page was fetched with a cursor, i.e. is not the first page
+func (searchRes SearchResponse) Paginated() bool {
+	return searchRes.start != FirstPage
 }
 
 // Filter with BaseItem, query and start
@@ -413,16 +1616,41 @@ func (searchRes SearchResponse) Next() (SearchResponse, error) {
 //	item, _ := builder.Build()
 //	res, err := item.Filter("CRYP", "QW9Fc1FrSkhNREF3TTBoYVdEVXkgMQ==.+avM2j1t25UWj8se/VnwSBhcM8LYMVpYykjqLj8hw70=")
 func (item BaseItem) Filter(query string, start string) (res FilterResponse, err error) {
-	res, err = postBaseItem[FilterResponse]("/filter", item, query, start)
+	ctx, cancel := defaultContext()
+	defer cancel()
+	return item.filter(ctx, query, start)
+}
+
+func (item BaseItem) filter(ctx context.Context, query string, start string) (res FilterResponse, err error) {
+	endpoint := APIEndpoints().Filter
+	res, err = postBaseItem[FilterResponse](ctx, endpoint, item, query, start)
+	res.endpoint = endpoint
 	res.baseitem = item
 	res.query = query
+	res.start = start
+	if err == nil && res.Error != "" {
+		err = &ResponseError{res.Error}
+	}
 
 	return
 }
 
+// Filter with BaseItem and the query stored by [BaseItemBuilder.SetQuery]
+//
+// Usage:
+//
+//	builder := BaseItem{}.GetBuilder()
+//	builder.SetCurrency("AUD").SetQuery("CRYP")
+//	item, _ := builder.Build()
+//	res, err := item.FilterBuilt(ctx)
+func (item BaseItem) FilterBuilt(ctx context.Context) (res FilterResponse, err error) {
+	return item.filter(ctx, item.Query, FirstPage)
+}
+
 // Continue filtering with previous FilterResponse
 // using the "next" field of API response.
-// Returns an error if there are no more results or filter error
+// Returns [ErrNoMoreResults] if there are no more results, or the filter error.
+// Like [SearchResponse.Next], the response is not advanced.
 //
 // Usage:
 //
@@ -437,23 +1665,113 @@ func (item BaseItem) Filter(query string, start string) (res FilterResponse, err
 //	}
 func (filterRes FilterResponse) Next() (FilterResponse, error) {
 	if filterRes.NextHash == "" {
-		return FilterResponse{}, fmt.Errorf("no more results")
+		return FilterResponse{}, ErrNoMoreResults
+	}
+	next, err := filterRes.baseitem.Filter(filterRes.query, filterRes.NextHash)
+	next.largestPage = filterRes.largestPageSize()
+	next.pagesBefore = filterRes.pagesBefore + 1
+	return next, err
+}
+
+// Advance the response in place to the next page, see [SearchResponse.NextMutable]
+func (filterRes *FilterResponse) NextMutable() error {
+	next, err := filterRes.Next()
+	if err != nil {
+		return err
+	}
+	*filterRes = next
+	return nil
+}
+
+// Estimated number of pages to fetch every result, from `Total` and the
+// largest page seen while paginating to this one, so a short last page does
+// not inflate it. 0 if the page is empty.
+func (filterRes FilterResponse) EstimatedPages() int {
+	if len(filterRes.Data) == 0 {
+		return 0
 	}
-	return filterRes.baseitem.Filter(filterRes.query, filterRes.NextHash)
+	pageSize := filterRes.largestPageSize()
+	return (filterRes.Total + pageSize - 1) / pageSize
+}
+
+// Page of [BaseItem.SearchOrFilter]: a search page, with the total of a filter
+type SearchOrFilterResponse struct {
+	SearchResponse
+	// Total number of results, nil for a search
+	Total *int
+}
+
+// Search, or filter when wantTotal is true to get the total number of results,
+// e.g. for call sites that only sometimes need the count.
+// The following pages are fetched from the same endpoint.
+//
+// Usage:
+//
+//	res, err := item.SearchOrFilter(ctx, "IBM", FirstPage, showCount)
+//	if res.Total != nil {
+//		fmt.Println(*res.Total, "results")
+//	}
+func (item BaseItem) SearchOrFilter(ctx context.Context, query string, start string, wantTotal bool) (SearchOrFilterResponse, error) {
+	if wantTotal {
+		res, err := item.filter(ctx, query, start)
+		return SearchOrFilterResponse{SearchResponse: res.SearchResponse, Total: &res.Total}, err
+	}
+	res, err := item.search(ctx, query, start)
+	return SearchOrFilterResponse{SearchResponse: res}, err
+}
+
+// The page as a [FilterResponse], ok if it was filtered
+func (res SearchOrFilterResponse) asFilter() (filterRes FilterResponse, ok bool) {
+	if res.Total == nil {
+		return
+	}
+	return FilterResponse{SearchResponse: res.SearchResponse, Total: *res.Total}, true
+}
+
+// Next page, from the endpoint of this one, see [SearchResponse.Next]
+func (res SearchOrFilterResponse) Next() (SearchOrFilterResponse, error) {
+	if res.NextHash == "" {
+		return SearchOrFilterResponse{}, ErrNoMoreResults
+	}
+	ctx, cancel := defaultContext()
+	defer cancel()
+	next, err := res.baseitem.SearchOrFilter(ctx, res.query, res.NextHash, res.Total != nil)
+	next.largestPage = res.largestPageSize()
+	next.pagesBefore = res.pagesBefore + 1
+	return next, err
+}
+
+// Advance the response in place to the next page, see [SearchResponse.NextMutable]
+func (res *SearchOrFilterResponse) NextMutable() error {
+	next, err := res.Next()
+	if err != nil {
+		return err
+	}
+	*res = next
+	return nil
 }
 
 // ========================= AUXILIARY FUNC =========================
 
+// Copy of the value behind p, nil if p is nil
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
 // Possible values of a property from the API
 func valuesUrl(property string) string {
-	return APIBaseUrl() + "/mapping/values/" + property
+	return APIBaseUrl() + APIEndpoints().Values + property
 }
 
 // ========================= INIT =========================
 
 // Set the default API base URL
 func init() {
-	SetAPIBaseUrl("https://api.openfigi.com/v3")
+	SetAPIBaseUrl(DefaultAPIBaseUrl)
 }
 
 // ========================= CODEGEN =========================
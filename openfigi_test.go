@@ -1,17 +1,31 @@
 package openfigi
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
 	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/minh-dng/openfigi-go/constants"
+	"golang.org/x/exp/constraints"
 )
 
 type middleware func(http.HandlerFunc) http.HandlerFunc
@@ -55,13 +69,6 @@ func jsonDecode[T any](r *http.Request) (payload T, err error) {
 	return
 }
 
-func shouldPanic(t *testing.T, f func()) {
-	t.Helper()
-	defer func() { _ = recover() }()
-	f()
-	t.Errorf("should have panicked")
-}
-
 // === TESTs ===
 
 func TestMapping(t *testing.T) {
@@ -91,6 +98,89 @@ func TestMapping(t *testing.T) {
 	if res[0].Data[0].FIGI != "BBG000BLNNH6" {
 		t.Errorf("Expected FIGI to be BBG000BLNNH6, got %s", res[0].Data[0].FIGI)
 	}
+
+	if res[0].Data[0].Sector() != MarketSectorEquity {
+		t.Errorf("Expected sector to be Equity, got %s", res[0].Data[0].Sector())
+	}
+	if res[0].Data[0].SecurityTypeTyped() != constants.SECURITYTYPE_CommonStock {
+		t.Errorf("Expected security type to be Common Stock, got %s", res[0].Data[0].SecurityTypeTyped())
+	}
+}
+
+func TestEmptyMappingRequest(t *testing.T) {
+	// Create test server
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		mappingHandler(w, r)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	if _, err := (MappingRequest{}).Fetch(); !errors.Is(err, ErrEmptyRequest) {
+		t.Errorf("Expected ErrEmptyRequest, got %v", err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Errorf("Expected no call, got %d", n)
+	}
+}
+
+func TestMapOneMarketSecDes(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := jsonDecode[MappingRequest](r)
+		if err != nil || payload[0].MarketSecDes != constants.MARKETSECDES_Equity || payload[0].ExchCode != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"data": [{"figi": "BBG000BLNNH6"}, {"figi": "BBG000BLNQ16"}]}]`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	objs, err := MapOne(context.Background(), constants.IDTYPE_TICKER, "IBM", BaseItem{MarketSecDes: constants.MARKETSECDES_Equity})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(objs) != 2 {
+		t.Errorf("Expected 2 FIGIs, got %d", len(objs))
+	}
+}
+
+func TestMapCompositeFIGI(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := jsonDecode[MappingRequest](r)
+		if err != nil || payload[0].Type != constants.IDTYPE_COMPOSITE_ID_BB_GLOBAL {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]SingleMappingResponse{{Data: []FIGIObject{
+			{FIGI: "BBG000BLNNH6", CompositeFIGI: "BBG000BLNNH6", ExchangeCode: "US"},
+			{FIGI: "BBG000BLNQ16", CompositeFIGI: "BBG000BLNNH6", ExchangeCode: "UN"},
+		}}})
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := MapCompositeFIGI(context.Background(), "BBG000BLNNH6")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 2 || res[1].CompositeFIGI != "BBG000BLNNH6" {
+		t.Errorf("Unexpected response: %+v", res)
+	}
 }
 
 func TestMappingTooManyItems(t *testing.T) {
@@ -116,228 +206,2803 @@ func TestMappingTooManyItems(t *testing.T) {
 	}
 }
 
-func TestSearch(t *testing.T) {
+func TestLogBodies(t *testing.T) {
 	// Create test server
 	mux := http.NewServeMux()
-	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	mux.HandleFunc("/mapping", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid idType"))
+	})
 	ts := httptest.NewServer(mux)
 	defer ts.Close()
 
 	SetAPIBaseUrl(ts.URL)
 
-	builder := BaseItem{}.GetBuilder()
-	builder.SetExchCode(constants.EXCHCODE_AU)
-	item, err := builder.Build()
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+
+	var apiErr *APIError
+	_, err := MappingRequest{map_item}.Fetch()
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+	if apiErr.Body != nil {
+		t.Errorf("Expected no body, got %s", apiErr.Body)
+	}
+
+	SetLogBodies(true)
+	defer SetLogBodies(false)
+	_, err = MappingRequest{map_item}.Fetch()
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+	if string(apiErr.Body) != "Invalid idType" {
+		t.Errorf("Expected body to be Invalid idType, got %s", apiErr.Body)
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	// Create test server, failing the second chunk
+	calls := 0
+	var mu sync.Mutex
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		call := calls
+		mu.Unlock()
+		if call == 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	req := slices.Repeat(MappingRequest{map_item}, 25)
+
+	res, err := req.FetchAll(context.Background())
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+	if err == nil || !strings.Contains(err.Error(), "chunk 1 (items 10-19): 429") {
+		t.Errorf("Expected chunk 1 error, got %v", err)
+	}
+	// The mock returns one response per request
+	if len(res) != 2 {
+		t.Errorf("Expected 2 responses, got %d", len(res))
+	}
+}
+
+func TestValidateBeforeFetch(t *testing.T) {
+	// Create test server
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	defer SetValidateBeforeFetch(true)
+
+	req := MappingRequest{
+		{Type: constants.IDTYPE_TICKER, Value: "IBM"},
+		{Type: "TICKR", Value: "AAPL"},
+	}
+	if _, err := req.Fetch(); err == nil || !strings.HasPrefix(err.Error(), "item 1: bad `idType`") || calls.Load() != 0 {
+		t.Errorf("Expected an item 1 error without call, got %v", err)
+	}
+	if _, err := req.FetchAll(context.Background()); err == nil || calls.Load() != 0 {
+		t.Errorf("Expected an error without call, got %v", err)
+	}
+
+	SetValidateBeforeFetch(false)
+	if _, err := req.Fetch(); err != nil || calls.Load() != 1 {
+		t.Errorf("Expected the request sent, got %v", err)
+	}
+}
+
+func TestFetchAllSplitting(t *testing.T) {
+	// Create test server, rejecting more than 3 items, failing a request with
+	// the value "fail", and echoing each value
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		payload, _ := jsonDecode[MappingRequest](r)
+		if len(payload) > 3 {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		if slices.ContainsFunc(payload, func(item MappingItem) bool { return item.Value == "fail" }) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		res := make([]SingleMappingResponse, len(payload))
+		for i, item := range payload {
+			res[i].Data = []FIGIObject{{Ticker: item.Value.(string)}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	req := make(MappingRequest, 10)
+	for i := range req {
+		req[i] = MappingItem{Type: constants.IDTYPE_TICKER, Value: strconv.Itoa(i)}
+	}
+	res, err := req.FetchAll(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != 10 {
+		t.Fatalf("Expected 10 responses, got %d", len(res))
+	}
+	for i, r := range res {
+		if r.Data[0].Ticker != strconv.Itoa(i) {
+			t.Errorf("Expected response %d in order, got %s", i, r.Data[0].Ticker)
+		}
+	}
+	// 10 -> 5 + 5 -> 2 + 3 + 2 + 3
+	if calls.Load() != 7 {
+		t.Errorf("Expected 7 calls, got %d", calls.Load())
+	}
+
+	// The second half failing keeps the first
+	req[8].Value = "fail"
+	res, err = req.fetchSplitting(context.Background())
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(res) != 10 {
+		t.Fatalf("Expected 10 responses, got %d", len(res))
+	}
+	for i, r := range res[:7] {
+		if r.Error != "" || r.Data[0].Ticker != strconv.Itoa(i) {
+			t.Errorf("Expected response %d kept, got %v", i, r)
+		}
+	}
+	for i, r := range res[7:] {
+		if r.Error == "" {
+			t.Errorf("Expected response %d to carry the error", i+7)
+		}
+	}
+
+	// So does the progress path, to the callback and in the result
+	var chunkRes []SingleMappingResponse
+	res, err = req.FetchAllProgress(context.Background(), 1, func(_, _, _ int, r []SingleMappingResponse, _ error) {
+		chunkRes = r
+	})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if len(res) != 10 || len(chunkRes) != 10 {
+		t.Fatalf("Expected 10 responses in the result and the callback, got %d and %d", len(res), len(chunkRes))
+	}
+	if res[6].Error != "" || res[6].Data[0].Ticker != "6" || res[7].Error == "" {
+		t.Errorf("Expected the fetched half kept, got %v", res)
+	}
+}
+
+func TestFetchAllProgress(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	map_item := MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+	req := slices.Repeat(MappingRequest{map_item}, 25)
+
+	var indices, starts []int
+	_, err := req.FetchAllProgress(context.Background(), 1, func(index, total, start int, res []SingleMappingResponse, err error) {
+		if total != 3 || err != nil || len(res) == 0 {
+			t.Errorf("Unexpected chunk %d/%d: %v, %v", index, total, res, err)
+		}
+		indices = append(indices, index)
+		starts = append(starts, start)
+	})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if !slices.Equal(indices, []int{0, 1, 2}) {
+		t.Errorf("Expected chunks [0 1 2] in order, got %v", indices)
+	}
+	if !slices.Equal(starts, []int{0, 10, 20}) {
+		t.Errorf("Expected chunks to start at [0 10 20], got %v", starts)
+	}
+}
+
+func TestMapBatch(t *testing.T) {
+	// Create test server, failing the second chunk once failSecond is set
+	var calls atomic.Int32
+	var failSecond atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 2 && failSecond.Load() {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
 
-	res, err := item.Search("", "")
+	venue := BaseItem{ExchCode: constants.EXCHCODE_US}
+	values := slices.Repeat([]any{"IBM"}, 15)
+	res, err := MapBatch(context.Background(), constants.IDTYPE_TICKER, values, venue)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.Data) == 0 {
-		t.Fatalf("Expected data, got none")
+	// The mock returns one response per request, at the chunk's first item
+	if len(res) != len(values) || calls.Load() != 2 {
+		t.Errorf("Expected 2 chunks aligned with %d values, got %d responses in %d calls", len(values), len(res), calls.Load())
+	}
+	if len(res[0].Data) == 0 || len(res[10].Data) == 0 {
+		t.Errorf("Expected responses at the first item of each chunk, got %v", res)
 	}
 
-	res, err = res.Next()
+	_, err = MapBatch(context.Background(), constants.IDTYPE_TICKER, []any{"IBM", 1.5}, venue)
+	if err == nil || !strings.HasPrefix(err.Error(), "item 1:") || calls.Load() != 2 {
+		t.Errorf("Expected item 1 error without call, got %v", err)
+	}
+
+	calls.Store(0)
+	failSecond.Store(true)
+	values = slices.Repeat([]any{"IBM"}, 25)
+	res, err = MapBatch(context.Background(), constants.IDTYPE_TICKER, values, venue)
+	if err == nil || !strings.Contains(err.Error(), "chunk 1 (items 10-19): 429") {
+		t.Errorf("Expected chunk 1 error, got %v", err)
+	}
+	if len(res) != len(values) {
+		t.Fatalf("Expected %d responses, got %d", len(values), len(res))
+	}
+	if len(res[0].Data) == 0 || len(res[20].Data) == 0 {
+		t.Errorf("Expected the chunks around the failed one to be mapped, got %v", res)
+	}
+	for i := 10; i < 20; i++ {
+		if !strings.Contains(res[i].Error, "429") {
+			t.Errorf("Expected item %d to carry the chunk error, got %q", i, res[i].Error)
+		}
+	}
+}
+
+func TestInferIDType(t *testing.T) {
+	tests := map[string]IDType{
+		"BBG000BLNNH6":   constants.IDTYPE_ID_BB_GLOBAL,
+		" bbg000b9xry4 ": constants.IDTYPE_ID_BB_GLOBAL,
+		"US0378331005":   constants.IDTYPE_ID_ISIN,
+		"AU000000BHP4":   constants.IDTYPE_ID_ISIN,
+		"037833100":      constants.IDTYPE_ID_CUSIP,
+		"US0378331006":   "", // Bad check digit
+		"BBG000BLNNH0":   "",
+		"AAPL":           "",
+	}
+	for value, want := range tests {
+		if got, ok := InferIDType(value); got != want || ok != (want != "") {
+			t.Errorf("InferIDType(%q) = %q, %v, expected %q", value, got, ok, want)
+		}
+	}
+}
+
+func TestMapAuto(t *testing.T) {
+	// Create test server, naming each result after its job's idType
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := jsonDecode[MappingRequest](r)
+		res := make([]SingleMappingResponse, len(payload))
+		for i, item := range payload {
+			res[i].Data = []FIGIObject{{Name: string(item.Type)}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := MapAuto(context.Background(), "US0378331005", "AAPL", "BBG000BLNNH6")
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.Data) == 0 {
-		t.Fatalf("Expected data, got none")
+	if len(res) != 3 || res[1].Inferred || len(res[1].Response.Data) != 0 {
+		t.Fatalf("Expected AAPL not to be sent, got %+v", res)
 	}
+	for _, i := range []int{0, 2} {
+		if !res[i].Inferred || res[i].Response.Data[0].Name != string(res[i].IDType) {
+			t.Errorf("Expected the response of %s, got %+v", res[i].IDType, res[i])
+		}
+	}
+}
 
-	res, err = res.Next()
+func TestPartialFIGIOnly(t *testing.T) {
+	// Create test server answering with the metadata fixture
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		fContent, err := os.ReadFile(filepath.Join("test", "mapping-metadata.json"))
+		if err != nil {
+			panic(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fContent)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	var logs strings.Builder
+	SetLogger(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	defer SetLogger(nil)
+
+	req := MappingRequest{
+		{Type: constants.IDTYPE_ID_BB_GLOBAL, Value: "BBG000BLNNH6"},
+		{Type: constants.IDTYPE_TICKER, Value: "AAPL"},
+	}
+	res, err := req.Fetch()
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.Data) != 0 {
-		t.Fatalf("Expected no data, got %d", len(res.Data))
+	if !res[0].PartialFIGIOnly() || res[1].PartialFIGIOnly() {
+		t.Errorf("Expected only the first job to be FIGI-only")
+	}
+	if !strings.Contains(logs.String(), "level=DEBUG msg=\"1 of 2 mapping jobs") {
+		t.Errorf("Expected a debug line by default, got %q", logs.String())
+	}
+
+	logs.Reset()
+	SetWarnFIGIOnly(true)
+	defer SetWarnFIGIOnly(false)
+	if _, err := req.Fetch(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(logs.String(), "level=WARN") || !strings.Contains(logs.String(), "1 of 2 mapping jobs") {
+		t.Errorf("Expected a warning under SetWarnFIGIOnly, got %q", logs.String())
 	}
 }
 
-func TestFilter(t *testing.T) {
+func TestCache(t *testing.T) {
 	// Create test server
+	calls := 0
 	mux := http.NewServeMux()
-	mux.HandleFunc("/filter", chain(filterHandler, method("POST"), jsonContentType()))
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetCache(NewLRUCache(10), time.Minute)
+	defer SetCache(nil, 0)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	for range 3 {
+		res, err := MappingRequest{map_item}.Fetch()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(res) != 1 || res[0].Data[0].FIGI != "BBG000BLNNH6" {
+			t.Errorf("Unexpected response: %+v", res)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+
+	// Another API key or server is not served the cached response
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	if _, err := (MappingRequest{map_item}).Fetch(); err != nil || calls != 2 {
+		t.Errorf("Expected a call under another API key, got %d calls (%v)", calls, err)
+	}
+	other := httptest.NewServer(mux)
+	defer other.Close()
+	SetAPIBaseUrl(other.URL)
+	if _, err := (MappingRequest{map_item}).Fetch(); err != nil || calls != 3 {
+		t.Errorf("Expected a call to another base URL, got %d calls (%v)", calls, err)
+	}
+}
+
+func TestCacheSkipsErrors(t *testing.T) {
+	// Create test server answering a JSON 403, a status the package does not describe
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error": "Forbidden"}`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetCache(NewLRUCache(10), time.Minute)
+	defer SetCache(nil, 0)
+
+	map_item := MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+	for range 2 {
+		_, err := MappingRequest{map_item}.Fetch()
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+			t.Errorf("Expected a 403 APIError, got %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected the 403 not to be cached, got %d calls", calls)
+	}
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", []byte("1"), 0)
+	cache.Set("b", []byte("2"), 0)
+	cache.Get("a")
+	cache.Set("c", []byte("3"), 0)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("Expected b to be evicted")
+	}
+	if val, ok := cache.Get("a"); !ok || string(val) != "1" {
+		t.Errorf("Expected a to be 1, got %s", val)
+	}
+
+	cache.Set("e", []byte("5"), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := cache.Get("e"); ok {
+		t.Errorf("Expected e to be expired")
+	}
+}
+
+func TestRequestKey(t *testing.T) {
+	defer SetSanitizeQueries(false)
+
+	item := BaseItem{ExchCode: "US"}
+	first := SearchResponse{baseitem: item, query: "IBM", start: FirstPage, endpoint: "/search"}
+	next := SearchResponse{baseitem: item, query: "IBM", start: nextStartHash, endpoint: "/search"}
+	other := SearchResponse{baseitem: item, query: "AAPL", start: FirstPage, endpoint: "/search"}
+	filtered := FilterResponse{SearchResponse: SearchResponse{baseitem: item, query: "IBM", endpoint: "/filter"}}
+
+	// SHA-256 of "POST /search\n" and {"exchCode":"US","query":"IBM"}
+	const want = "47bcac509ffd3b92dbc6df8e9e79eec897ceaba1b2333f8452846859e63e80f9"
+	if key := first.RequestKey(); key != want {
+		t.Errorf("Expected key %s, got %s", want, key)
+	}
+	if next.RequestKey() != first.RequestKey() {
+		t.Errorf("Expected the same key for every page")
+	}
+	if other.RequestKey() == first.RequestKey() {
+		t.Errorf("Expected a different key for another query")
+	}
+	if filtered.RequestKey() == first.RequestKey() {
+		t.Errorf("Expected a different key for the filter endpoint")
+	}
+
+	spaced := SearchResponse{baseitem: item, query: " IBM ", endpoint: "/search"}
+	key := spaced.RequestKey()
+	SetSanitizeQueries(true)
+	if spaced.RequestKey() != key || key == want {
+		t.Errorf("Expected the raw query hashed whatever SanitizeQueries")
+	}
+}
+
+func TestFetchAllValues(t *testing.T) {
+	// Create test server
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping/values/{key}", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"values": ["%s-1", "%s-2"]}`, r.PathValue("key"), r.PathValue("key"))
+	}, method("GET")))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetValuesCacheTTL(time.Minute)
+	defer resetValuesCache()
+
+	for range 2 {
+		res, err := FetchAllValues(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(res) != len(valueKeys) {
+			t.Errorf("Expected %d keys, got %d", len(valueKeys), len(res))
+		}
+		if !slices.Equal(res["currency"], []string{"currency-1", "currency-2"}) {
+			t.Errorf("Unexpected currency values: %v", res["currency"])
+		}
+	}
+	if int(calls.Load()) != len(valueKeys) {
+		t.Errorf("Expected %d calls, got %d", len(valueKeys), calls.Load())
+	}
+
+	if _, err := FetchValues(context.Background(), "currencies"); err == nil || int(calls.Load()) != len(valueKeys) {
+		t.Errorf("Expected an unknown key error without call, got %v", err)
+	}
+}
+
+func TestRefreshValueSets(t *testing.T) {
+	// Create test server, with a slow stateCode
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping/values/{key}", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.PathValue("key") == "stateCode" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"values": ["%s-1"]}`, r.PathValue("key"))
+	}, method("GET")))
 	ts := httptest.NewServer(mux)
 	defer ts.Close()
 
 	SetAPIBaseUrl(ts.URL)
+	defer resetValuesCache()
+
+	if _, err := FetchValues(context.Background(), "currency"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	refreshed, err := RefreshValueSets(context.Background(), 50*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) || !strings.HasPrefix(err.Error(), "stateCode:") {
+		t.Errorf("Expected a stateCode timeout, got %v", err)
+	}
+	if len(refreshed) != len(valueKeys)-1 || slices.Contains(refreshed, "stateCode") {
+		t.Errorf("Expected every key but stateCode, got %v", refreshed)
+	}
+	// currency is fetched again despite the cache
+	if n := calls.Load(); int(n) != len(valueKeys)+1 {
+		t.Errorf("Expected %d calls, got %d", len(valueKeys)+1, n)
+	}
+}
+
+func TestFetchValuesErrors(t *testing.T) {
+	// Create test server, rate limiting every request
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping/values/{key}", chain(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("X-OPENFIGI-APIKEY"); key != "key" {
+			t.Errorf("Expected the API key, got %q", key)
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, method("GET")))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	defer resetValuesCache()
+	SetValuesCacheTTL(0)
+
+	_, err := FetchValues(context.Background(), constants.ValueKeyCurrency)
+	var apiErr *APIError
+	if !errors.Is(err, ErrRateLimited) || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Expected a rate-limited APIError, got %v", err)
+	}
+	if _, err := RefreshValueSets(context.Background(), time.Second); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited wrapped in the refresh error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := FetchValues(ctx, constants.ValueKeyCurrency); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a canceled request, got %v", err)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	req := slices.Repeat(MappingRequest{map_item}, 25)
+	req[3].Type = "zigzagzig"
+
+	chunks, err := req.DryRun()
+	if chunks != 3 {
+		t.Errorf("Expected 3 chunks, got %d", chunks)
+	}
+	if err == nil || !strings.HasPrefix(err.Error(), "item 3:") {
+		t.Errorf("Expected item 3 error, got %v", err)
+	}
+
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	if chunks, _ := req.DryRun(); chunks != 1 {
+		t.Errorf("Expected 1 chunk with an API key, got %d", chunks)
+	}
+}
+
+func TestMaxMappingJobs(t *testing.T) {
+	if n := MaxMappingJobs(); n != 10 {
+		t.Errorf("Expected 10 jobs without an API key, got %d", n)
+	}
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	if n := MaxMappingJobs(); n != 100 {
+		t.Errorf("Expected 100 jobs with an API key, got %d", n)
+	}
+}
+
+func TestCostEstimate(t *testing.T) {
+	req := make(MappingRequest, 25)
+	if cost := req.CostEstimate(); cost != (CostEstimate{Jobs: 25, Requests: 3}) {
+		t.Errorf("Expected 25 jobs in 3 requests, got %+v", cost)
+	}
+
+	filterRes := FilterResponse{SearchResponse: SearchResponse{Data: make([]FIGIObject, 100)}, Total: 250}
+	if cost := filterRes.CostEstimate(0); cost.Jobs != 3 {
+		t.Errorf("Expected 3 jobs, got %+v", cost)
+	}
+	if cost := filterRes.CostEstimate(2); cost.Jobs != 2 {
+		t.Errorf("Expected 2 jobs, got %+v", cost)
+	}
+	filterRes.pagesBefore = 1
+	if cost := filterRes.CostEstimate(0); cost.Jobs != 2 {
+		t.Errorf("Expected 2 jobs from the second page, got %+v", cost)
+	}
+	filterRes.pagesBefore = 2
+	if cost := filterRes.CostEstimate(0); cost.Jobs != 1 {
+		t.Errorf("Expected 1 job on the last page, got %+v", cost)
+	}
+
+	searchRes := SearchResponse{NextHash: "next"}
+	if cost := searchRes.CostEstimate(5); cost != (CostEstimate{Jobs: 5, Requests: 5}) {
+		t.Errorf("Expected 5 jobs, got %+v", cost)
+	}
+	if cost := searchRes.CostEstimate(0); !cost.Unbounded {
+		t.Errorf("Expected an unbounded cost without page limit, got %+v", cost)
+	}
+	if cost := (SearchResponse{}).CostEstimate(0); cost != (CostEstimate{Jobs: 1, Requests: 1}) {
+		t.Errorf("Expected 1 job for the last page, got %+v", cost)
+	}
+}
+
+func TestAPIEndpoints(t *testing.T) {
+	// Create test server behind a prefix
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openfigi/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetAPIEndpoints(Endpoints{Mapping: "/openfigi/mapping"})
+	defer SetAPIEndpoints(DefaultEndpoints)
+
+	if APIEndpoints().Search != DefaultEndpoints.Search {
+		t.Errorf("Expected search endpoint to be %s, got %s", DefaultEndpoints.Search, APIEndpoints().Search)
+	}
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	if _, err := (MappingRequest{map_item}).Fetch(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestResetConfig(t *testing.T) {
+	SetAPIBaseUrl("http://localhost")
+	SetAPIKey("key")
+	SetHTTPClient(&http.Client{})
+	SetLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	SetMaxRetries(3)
+	SetValuesCacheTTL(time.Minute)
+	storeValues("currency", []string{"AUD"})
+
+	ResetConfig()
+	if url := APIBaseUrl(); url != DefaultAPIBaseUrl {
+		t.Errorf("Expected %s, got %s", DefaultAPIBaseUrl, url)
+	}
+	if APIKey() != "" || MaxRetries() != 0 {
+		t.Errorf("Expected no API key and retries, got %q and %d", APIKey(), MaxRetries())
+	}
+	if httpClient() != http.DefaultClient || logger() != slog.Default() {
+		t.Errorf("Expected the default client and logger")
+	}
+	if _, ok := cachedValues("currency"); ok || valuesCache.value.ttl != DefaultValuesCacheTTL {
+		t.Errorf("Expected an empty values cache with the default TTL, got TTL %v", valuesCache.value.ttl)
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	defer ResetConfig()
+
+	for _, cfg := range []Config{
+		{BaseURL: "api.openfigi.com"},
+		{RateLimitPerMinute: -1},
+		{MaxRetries: -1},
+	} {
+		if err := Configure(cfg); err == nil {
+			t.Errorf("Expected error for %+v", cfg)
+		}
+	}
+	if APIBaseUrl() != DefaultAPIBaseUrl {
+		t.Errorf("Expected an invalid config not to be applied, got %s", APIBaseUrl())
+	}
+
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client := &http.Client{}
+	err := Configure(Config{BaseURL: ts.URL, APIKey: "key", HTTPClient: client, RateLimitPerMinute: 600, MaxRetries: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if APIBaseUrl() != ts.URL || APIKey() != "key" || httpClient() != client || MaxRetries() != 2 {
+		t.Errorf("Expected every setting applied")
+	}
+
+	// 600 a minute spaces requests 100ms apart
+	start := time.Now()
+	for range 3 {
+		if _, err := (MappingRequest{{Type: constants.IDTYPE_TICKER, Value: "IBM"}}).Fetch(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("Expected requests 100ms apart, took %v", elapsed)
+	}
+}
+
+func TestConfigSnapshot(t *testing.T) {
+	defer ResetConfig()
+
+	// Each server only accepts its own key
+	servers := map[string]*httptest.Server{}
+	for _, key := range []string{"a", "b"} {
+		servers[key] = httptest.NewServer(chain(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("X-OPENFIGI-APIKEY"); got != key {
+				t.Errorf("Expected key %s at server %s, got %q", key, key, got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[{"data": []}]`))
+		}, method("POST"), jsonContentType()))
+		defer servers[key].Close()
+	}
+	Configure(Config{BaseURL: servers["a"].URL, APIKey: "a"})
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			key := []string{"a", "b"}[i%2]
+			Configure(Config{BaseURL: servers[key].URL, APIKey: key})
+		}
+	}()
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				if _, err := (MappingRequest{{Type: constants.IDTYPE_TICKER, Value: "IBM"}}).Fetch(); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				resp, err := DoRequest(context.Background(), "POST", "", MappingRequest{})
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+					continue
+				}
+				resp.Body.Close()
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+}
+
+// Doer answering every request with body, recording the requested URLs
+type stubDoer struct {
+	body string
+	urls []string
+}
+
+func (d *stubDoer) Do(req *http.Request) (*http.Response, error) {
+	d.urls = append(d.urls, req.URL.String())
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(d.body)),
+	}, nil
+}
+
+func TestSetDoer(t *testing.T) {
+	SetAPIBaseUrl(DefaultAPIBaseUrl)
+	stub := &stubDoer{body: `[{"data": [{"figi": "BBG000BLNNH6"}]}]`}
+	SetDoer(stub)
+	defer SetDoer(nil)
+
+	res, err := MappingRequest{{Type: constants.IDTYPE_TICKER, Value: "IBM"}}.Fetch()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res[0].Data[0].FIGI != "BBG000BLNNH6" {
+		t.Errorf("Expected the stub's response, got %+v", res)
+	}
+	if want := DefaultAPIBaseUrl + "/mapping"; len(stub.urls) != 1 || stub.urls[0] != want {
+		t.Errorf("Expected one request to %s, got %v", want, stub.urls)
+	}
+
+	SetHTTPClient(nil)
+	if httpClient() != http.DefaultClient {
+		t.Errorf("Expected SetHTTPClient to replace the doer")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	// Create test server, rate limited on the first call
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetMaxRetries(1)
+	defer SetMaxRetries(0)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	res, err := MappingRequest{map_item}.Fetch()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+	if len(res) != 1 {
+		t.Errorf("Expected 1 response, got %d", len(res))
+	}
+}
+
+func TestLastRateLimit(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "25")
+		w.Header().Set("X-RateLimit-Remaining", "24")
+		w.Header().Set("X-RateLimit-Reset", "6")
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	if _, err := (MappingRequest{map_item}).Fetch(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := RateLimit{Limit: 25, Remaining: 24, Reset: 6 * time.Second}
+	if rl := LastRateLimit(); rl != expected {
+		t.Errorf("Expected %+v, got %+v", expected, rl)
+	}
+}
+
+// Counts the waits, refusing them once canceled
+type countingLimiter struct{ waits atomic.Int32 }
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits.Add(1)
+	return ctx.Err()
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	limiter := &countingLimiter{}
+	SetSharedRateLimiter(limiter)
+	defer SetSharedRateLimiter(nil)
+
+	map_item := MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+	if _, err := (MappingRequest{map_item}).Fetch(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := (BaseItem{}).Search("IBM", FirstPage); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if n := limiter.waits.Load(); n != 2 {
+		t.Errorf("Expected 2 waits, got %d", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := (BaseItem{}).SearchBuilt(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestAcceptHeader(t *testing.T) {
+	// Create test server, only serving JSON
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/json" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		searchHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	if _, err := (BaseItem{}).Search("IBM", FirstPage); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	SetDefaultHeaders(http.Header{"Accept": {"text/html"}})
+	defer SetDefaultHeaders(nil)
+	_, err := BaseItem{}.Search("IBM", FirstPage)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotAcceptable || !strings.Contains(apiErr.Message, "text/html") {
+		t.Errorf("Expected a 406 naming text/html, got %v", err)
+	}
+}
+
+func TestRequestMutator(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Signature") != "signed:key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		searchHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	defer SetRequestMutator(nil)
+
+	SetRequestMutator(func(req *http.Request) error {
+		req.Header.Set("X-Signature", "signed:"+req.Header.Get("X-OPENFIGI-APIKEY"))
+		return nil
+	})
+	if _, err := (BaseItem{}).Search("IBM", FirstPage); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	errSigning := errors.New("no signing key")
+	SetRequestMutator(func(req *http.Request) error { return errSigning })
+	if _, err := (BaseItem{}).Search("IBM", FirstPage); !errors.Is(err, errSigning) {
+		t.Errorf("Expected the mutator error, got %v", err)
+	}
+}
+
+func TestDoRequest(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/new-endpoint", chain(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-OPENFIGI-APIKEY") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": true}`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	_, err := DoRequest(context.Background(), "POST", "/new-endpoint", map[string]string{"a": "b"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected a 401, got %v", err)
+	}
+
+	SetAPIKey("key")
+	defer SetAPIKey("")
+	resp, err := DoRequest(context.Background(), "POST", "/new-endpoint", map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"ok": true}` {
+		t.Errorf("Unexpected body %s", body)
+	}
+}
+
+func TestUnexpectedContentType(t *testing.T) {
+	// Create test server answering with a proxy error page
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte("<html><body>Bad Gateway</body></html>"))
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	var apiErr *APIError
+	_, err := BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"text/html"`) || strings.Contains(err.Error(), "Bad Gateway") {
+		t.Errorf("Expected the content type without the body in the error, got %v", err)
+	}
+
+	SetLogBodies(true)
+	defer SetLogBodies(false)
+	_, err = BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !strings.Contains(err.Error(), `"text/html"`) || !strings.Contains(err.Error(), "Bad Gateway") {
+		t.Errorf("Expected the content type and body in the error, got %v", err)
+	}
+}
+
+func TestMaintenancePage(t *testing.T) {
+	// Create test server answering 200 with an HTML page declared as JSON
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("\n  <html><body>Down for maintenance</body></html>"))
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	var apiErr *APIError
+	_, err := BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !errors.As(err, &apiErr) || !apiErr.Maintenance {
+		t.Fatalf("Expected a maintenance APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusOK || strings.Contains(apiErr.Message, "Down for maintenance") {
+		t.Errorf("Expected status 200 without the page in the message, got %v", err)
+	}
+
+	SetLogBodies(true)
+	defer SetLogBodies(false)
+	_, err = BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !errors.As(err, &apiErr) || !strings.Contains(apiErr.Message, "Down for maintenance") {
+		t.Errorf("Expected the page in the message, got %v", err)
+	}
+}
+
+func TestNotFoundURL(t *testing.T) {
+	// Create test server, without the base URL's path
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL + "/v3")
+
+	var apiErr *APIError
+	_, err := BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 APIError, got %v", err)
+	}
+	if want := ts.URL + "/v3/search"; apiErr.URL != want || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s in the error, got %v", want, err)
+	}
+
+	// The base URL named is the one the request was sent to, even if it
+	// changes before the response arrives
+	mux.HandleFunc("/v2/search", func(w http.ResponseWriter, r *http.Request) {
+		SetAPIBaseUrl("http://elsewhere")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	SetAPIBaseUrl(ts.URL + "/v2")
+	defer SetAPIBaseUrl(DefaultAPIBaseUrl)
+	_, err = BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+	if !strings.Contains(err.Error(), "base URL "+ts.URL+"/v2") {
+		t.Errorf("Expected the base URL sent to in the error, got %v", err)
+	}
+}
+
+func TestOnMetric(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	var endpoints []string
+	var statusCodes []int
+	SetOnMetric(func(endpoint string, statusCode int, latency time.Duration, err error) {
+		endpoints = append(endpoints, endpoint)
+		statusCodes = append(statusCodes, statusCode)
+	})
+	defer SetOnMetric(nil)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	MappingRequest{map_item}.Fetch()
+	slices.Repeat(MappingRequest{map_item}, 11).Fetch()
+
+	if !slices.Equal(endpoints, []string{"/mapping", "/mapping"}) {
+		t.Errorf("Expected 2 /mapping metrics, got %v", endpoints)
+	}
+	if !slices.Equal(statusCodes, []int{http.StatusOK, http.StatusRequestEntityTooLarge}) {
+		t.Errorf("Expected status codes [200 413], got %v", statusCodes)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	// Create a slow test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	SetTimeout(10 * time.Millisecond)
+	defer SetTimeout(0)
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "IBM")
+	map_item, _ := map_builder.Build()
+	if _, err := (MappingRequest{map_item}).Fetch(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_AU)
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := item.Search("", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Data) == 0 {
+		t.Fatalf("Expected data, got none")
+	}
+	if res.Paginated() {
+		t.Errorf("Expected the first page")
+	}
+
+	res, err = res.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Data) == 0 {
+		t.Fatalf("Expected data, got none")
+	}
+	if !res.Paginated() {
+		t.Errorf("Expected a paginated page")
+	}
+	if res.Done() {
+		t.Errorf("Expected more pages")
+	}
+
+	res, err = res.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Data) != 0 {
+		t.Fatalf("Expected no data, got %d", len(res.Data))
+	}
+	if !res.IsEmpty() || res.HasMore() {
+		t.Errorf("Expected an empty last page")
+	}
+	if !res.Done() {
+		t.Errorf("Expected the last page to be done")
+	}
+	if !(SearchResponse{Data: res.Data, NextHash: nextStartHash}).Done() {
+		t.Errorf("Expected an empty page with a next hash to be done")
+	}
+
+	if _, err = res.Next(); !errors.Is(err, ErrNoMoreResults) {
+		t.Errorf("Expected ErrNoMoreResults, got %v", err)
+	}
+}
+
+func TestSearchHeader(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc123")
+		filterHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Filter("", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if id := res.Header().Get("X-Request-Id"); id != "abc123" {
+		t.Errorf("Expected request ID abc123, got %q", id)
+	}
+}
+
+func TestStrictDecode(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":[{"figi":"BBG000B9XRY4","newField":1}]}`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	defer SetStrictDecode(false)
+	defer SetKeepRaw(false)
+
+	SetKeepRaw(true)
+	res, err := BaseItem{}.Search("IBM", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(res.Raw), "newField") {
+		t.Errorf("Expected the raw payload, got %s", res.Raw)
+	}
+
+	SetStrictDecode(true)
+	if _, err := (BaseItem{}).Search("IBM", FirstPage); err == nil || !strings.Contains(err.Error(), "newField") {
+		t.Errorf("Expected an unknown field error, got %v", err)
+	}
+}
+
+func TestSanitizeQuery(t *testing.T) {
+	if q := SanitizeQuery("  IBM\n\tCORP  "); q != "IBM CORP" {
+		t.Errorf("Expected %q, got %q", "IBM CORP", q)
+	}
+
+	SetSanitizeQueries(true)
+	defer SetSanitizeQueries(false)
+	payload, _ := BaseItem{}.MarshalRequest(" IBM ", FirstPage)
+	if expected := `{"query":"IBM"}`; string(payload) != expected {
+		t.Errorf("Expected %s, got %s", expected, payload)
+	}
+}
+
+func TestDecodeResponses(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "search.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	res, err := DecodeSearchResponse(f)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Data) != 100 || res.NextHash != nextStartHash {
+		t.Errorf("Expected 100 objects and the next hash, got %d and %q", len(res.Data), res.NextHash)
+	}
+
+	filterRes, err := DecodeFilterResponse(strings.NewReader(`{"data": [{"figi": "A"}], "total": 7}`))
+	if err != nil || filterRes.Total != 7 {
+		t.Errorf("Expected total 7, got %d (%v)", filterRes.Total, err)
+	}
+
+	mappingRes, err := DecodeMappingResponses(strings.NewReader(`[{"data": [{"figi": "A"}]}, {"error": "Invalid idType"}]`))
+	if err != nil || len(mappingRes) != 2 || mappingRes[1].Error == "" {
+		t.Errorf("Expected 2 jobs with an error on the second, got %+v (%v)", mappingRes, err)
+	}
+}
+
+func TestSearchBuilt(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_AU).SetQuery("AGK")
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := item.SearchBuilt(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.Data) == 0 {
+		t.Fatalf("Expected data, got none")
+	}
+	if res.query != "AGK" {
+		t.Errorf("Expected query to be AGK, got %s", res.query)
+	}
+}
+
+func TestBuilderSearch(t *testing.T) {
+	t.Run("invalid item", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetExchCode("zigzagzig")
+		if _, err := builder.Search(context.Background(), "AGK"); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+}
+
+func TestSearchBatch(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_AU)
+	item, _ := builder.Build()
+
+	items := []BaseItem{item, item, item}
+	res, err := SearchBatch(context.Background(), items, "AGK", 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res) != len(items) {
+		t.Fatalf("Expected %d responses, got %d", len(items), len(res))
+	}
+	for i, r := range res {
+		if len(r.Data) == 0 {
+			t.Errorf("Expected data for item %d, got none", i)
+		}
+	}
+}
+
+func TestFilterBatch(t *testing.T) {
+	// Create test server, failing for JP
+	totals := map[string]int{"US": 30, "AU": 12}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := jsonDecode[SearchRequest](r)
+		total, ok := totals[payload.ExchCode]
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [], "total": %d}`, total)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	items := []BaseItem{{ExchCode: "US"}, {ExchCode: "JP"}, {ExchCode: "AU"}}
+	perItem, grandTotal, err := FilterBatch(context.Background(), items, "BHP")
+	if err == nil || !strings.HasPrefix(err.Error(), "item 1:") {
+		t.Errorf("Expected item 1 error, got %v", err)
+	}
+	if grandTotal != 42 || perItem[0].Total != 30 || perItem[2].Total != 12 {
+		t.Errorf("Expected totals 30 and 12 summing to 42, got %d", grandTotal)
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	// Create test server, answering 200 with an error in the body
+	mux := http.NewServeMux()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [], "error": "Invalid query."}`))
+	}
+	mux.HandleFunc("/search", chain(handler, method("POST"), jsonContentType()))
+	mux.HandleFunc("/filter", chain(handler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	var respErr *ResponseError
+	if _, err := (BaseItem{}).Search("BHP", FirstPage); !errors.As(err, &respErr) || respErr.Message != "Invalid query." {
+		t.Errorf("Expected search ResponseError, got %v", err)
+	}
+	if _, err := (BaseItem{}).Filter("BHP", FirstPage); !errors.As(err, &respErr) || respErr.Message != "Invalid query." {
+		t.Errorf("Expected filter ResponseError, got %v", err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	var searchCalls atomic.Int32
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		searchCalls.Add(1)
+		searchHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := jsonDecode[SearchRequest](r)
+		if payload.Start == nextStartHash {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"figi": "BBG000B9XRY4"}], "next": "` + nextStartHash + `", "total": 2}`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	t.Run("all pages", func(t *testing.T) {
+		objs, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.All(context.Background(), "", CollectOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(objs) != 200 {
+			t.Errorf("Expected 200 objects, got %d", len(objs))
+		}
+	})
+	t.Run("max pages", func(t *testing.T) {
+		res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Search("", FirstPage)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		objs, err := res.CollectN(context.Background(), 1, CollectOptions{})
+		if err != nil || len(objs) != 100 {
+			t.Errorf("Expected the first page only, got %d objects (%v)", len(objs), err)
+		}
+	})
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.SearchBuilt(ctx)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		cancel()
+		before := searchCalls.Load()
+		objs, err := res.Collect(ctx, CollectOptions{})
+		if !errors.Is(err, context.Canceled) || len(objs) != 100 {
+			t.Errorf("Expected the first page and context.Canceled, got %d objects (%v)", len(objs), err)
+		}
+		if n := searchCalls.Load() - before; n != 0 {
+			t.Errorf("Expected no page fetched after cancel, got %d", n)
+		}
+	})
+	t.Run("per-page timeout", func(t *testing.T) {
+		res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Filter("", FirstPage)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		objs, err := res.Collect(context.Background(), CollectOptions{PerPageTimeout: 50 * time.Millisecond})
+		if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "page 2") {
+			t.Errorf("Expected a timeout on page 2, got %v", err)
+		}
+		if len(objs) != 1 {
+			t.Errorf("Expected the first page's object, got %d", len(objs))
+		}
+	})
+}
+
+func TestStream(t *testing.T) {
+	// Create test server, with pages that never end
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"figi": "BBG000B9XRY4"}, {"figi": "BBG000BLNNH6"}], "next": "` + nextStartHash + `"}`))
+	}, method("POST"), jsonContentType()))
+	mux.HandleFunc("/mapping", chain(mappingHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	// Wait for the goroutines of the stream to exit, failing after a second
+	waitGoroutines := func(t *testing.T, baseline int) {
+		t.Helper()
+		for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > baseline; {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected %d goroutines after cancel, got %d", baseline, runtime.NumGoroutine())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	t.Run("search", func(t *testing.T) {
+		res, err := BaseItem{ExchCode: constants.EXCHCODE_US}.Search("", FirstPage)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		baseline := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		results := res.Stream(ctx, CollectOptions{})
+		for range 5 {
+			if r := <-results; r.Err != nil || r.Value.FIGI == "" {
+				t.Fatalf("Unexpected result: %+v", r)
+			}
+		}
+		// Stop reading without draining: the producer must not block
+		cancel()
+		waitGoroutines(t, baseline)
+	})
+	t.Run("mapping", func(t *testing.T) {
+		req := make(MappingRequest, 30)
+		for i := range req {
+			req[i] = MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+		}
+		baseline := runtime.NumGoroutine()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		results := req.FetchStream(ctx)
+		if r := <-results; r.Err != nil || len(r.Value.Data) != 1 {
+			t.Fatalf("Unexpected result: %+v", r)
+		}
+		cancel()
+		waitGoroutines(t, baseline)
+	})
+	t.Run("drained", func(t *testing.T) {
+		req := make(MappingRequest, 30)
+		for i := range req {
+			req[i] = MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+		}
+		var n int
+		for r := range req.FetchStream(context.Background()) {
+			if r.Err != nil {
+				t.Fatalf("Unexpected error: %v", r.Err)
+			}
+			n++
+		}
+		// The mock returns one response per chunk
+		if n != 3 {
+			t.Errorf("Expected 3 responses, got %d", n)
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(filterHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_AU)
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	res, err := item.Filter("", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.SearchResponse.Data) == 0 {
+		t.Fatalf("Expected data, got none")
+	}
+
+	res, err = res.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.SearchResponse.Data) == 0 {
+		t.Fatalf("Expected data, got none")
+	}
+
+	res, err = res.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(res.SearchResponse.Data) != 0 {
+		t.Fatalf("Expected no data, got %d", len(res.SearchResponse.Data))
+	}
+	if res.Total != 1589028 {
+		t.Errorf("Expected total to be 1589028, got %d", res.Total)
+	}
+	if pages := res.EstimatedPages(); pages != 0 {
+		t.Errorf("Expected 0 pages for an empty page, got %d", pages)
+	}
+	res.Data = make([]FIGIObject, 100)
+	if pages := res.EstimatedPages(); pages != 15891 {
+		t.Errorf("Expected 15891 pages, got %d", pages)
+	}
+	if cost := res.CostEstimate(0); cost.Jobs != 15889 {
+		t.Errorf("Expected 15889 jobs from the third page, got %+v", cost)
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	// Create test server, echoing the query as the name
+	mux := http.NewServeMux()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := jsonDecode[SearchRequest](r)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data": [{"name": %q, "exchCode": %q}], "total": 1}`, payload.Query, payload.ExchCode)
+	}
+	mux.HandleFunc("/search", chain(handler, method("POST"), jsonContentType()))
+	mux.HandleFunc("/filter", chain(handler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	item := BaseItem{ExchCode: constants.EXCHCODE_US, Query: "AAPL"}
+	search := item.WithQuery("IBM")
+	searchRes, err := search.Search(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	filterRes, err := search.Filter(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, obj := range []FIGIObject{searchRes.Data[0], filterRes.Data[0]} {
+		if obj.Name != "IBM" || obj.ExchangeCode != constants.EXCHCODE_US {
+			t.Errorf("Expected IBM on US, got %+v", obj)
+		}
+	}
+}
+
+func TestSearchOrFilter(t *testing.T) {
+	// Create test server
+	var searchCalls, filterCalls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		searchCalls.Add(1)
+		searchHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		filterCalls.Add(1)
+		filterHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	item := BaseItem{ExchCode: constants.EXCHCODE_AU}
+
+	res, err := item.SearchOrFilter(context.Background(), "", FirstPage, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.Total != nil || searchCalls.Load() != 1 {
+		t.Errorf("Expected a search without total, got %v", res.Total)
+	}
+
+	res, err = item.SearchOrFilter(context.Background(), "", FirstPage, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	objs, err := res.Collect(context.Background(), CollectOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(objs) != 200 || filterCalls.Load() != 3 || searchCalls.Load() != 1 {
+		t.Errorf("Expected every page filtered, got %d objects, %d filter calls", len(objs), filterCalls.Load())
+	}
+	for res.HasMore() {
+		if err := res.NextMutable(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if res.Total == nil || *res.Total != 1589028 || searchCalls.Load() != 1 {
+		t.Errorf("Expected the last filter page with its total, got %v", res.Total)
+	}
+}
+
+func TestSearchRequest(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req SearchRequest
+		if err := json.Unmarshal(body, &req); err != nil || req.Query != "IBM" {
+			t.Errorf("Expected query IBM, got %q (%v)", req.Query, err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		filterHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	req, err := NewSearchRequest(BaseItem{ExchCode: constants.EXCHCODE_AU}).
+		SetQuery("IBM").
+		SetStart(nextStartHash).
+		Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	res, err := req.Do(context.Background(), APIEndpoints().Filter)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.NextHash != finalStartHash {
+		t.Errorf("Expected the page after the cursor, got next %q", res.NextHash)
+	}
+
+	if _, err := req.Do(context.Background(), "/mapping"); err == nil {
+		t.Error("Expected an error for an endpoint other than search or filter")
+	}
+	if _, err := NewSearchRequest(BaseItem{}).SetRequireConstraint(true).Build(); err == nil {
+		t.Error("Expected an error for a request matching everything")
+	}
+}
+
+func TestNextMutable(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(filterHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Filter("", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	pages := 1
+	for res.HasMore() {
+		if err := res.NextMutable(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		pages++
+	}
+	// The last page is empty, with the filter total
+	if pages != 3 || !res.IsEmpty() || res.Total != 1589028 {
+		t.Errorf("Expected 3 pages ending on an empty filter page, got %d pages and %+v", pages, res)
+	}
+	if err := res.NextMutable(); !errors.Is(err, ErrNoMoreResults) || res.Total != 1589028 {
+		t.Errorf("Expected ErrNoMoreResults leaving the page unchanged, got %v", err)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	// Create test server, with a page of 3 then a short last page of 1
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := jsonDecode[SearchRequest](r)
+		w.Header().Set("Content-Type", "application/json")
+		if payload.Start == nextStartHash {
+			w.Write([]byte(`{"data": [{"figi": "4"}], "total": 4}`))
+			return
+		}
+		w.Write([]byte(`{"data": [{"figi": "1"}, {"figi": "2"}, {"figi": "3"}], "next": "` + nextStartHash + `", "total": 4}`))
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Filter("", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if res.PageSize() != 3 || res.EstimatedPages() != 2 {
+		t.Errorf("Expected a page of 3 out of 2, got %d out of %d", res.PageSize(), res.EstimatedPages())
+	}
+	if err := res.NextMutable(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The short last page is not taken as the page size
+	if res.PageSize() != 1 || res.EstimatedPages() != 2 {
+		t.Errorf("Expected a page of 1 out of 2, got %d out of %d", res.PageSize(), res.EstimatedPages())
+	}
+}
+
+func TestValidateBaseItem(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+
+	t.Run("exchCode and micCode", func(t *testing.T) {
+		builder.SetExchCode(constants.EXCHCODE_AU)
+		builder.SetMicCode(constants.MICCODE_ADRK)
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad exchCode", func(t *testing.T) {
+		builder.SetExchCode("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad micCode", func(t *testing.T) {
+		builder.SetMicCode("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad currency", func(t *testing.T) {
+		builder.SetCurrency("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad marketSecDes", func(t *testing.T) {
+		builder.SetMarketSecDes("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad securityType", func(t *testing.T) {
+		builder.SetSecurityType("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad securityType2", func(t *testing.T) {
+		builder.SetSecurityType2("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad stateCode", func(t *testing.T) {
+		builder.SetStateCode("zigzagzig")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad Strike 1", func(t *testing.T) {
+		builder.SetStrike([2]any{nil, nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad Strike 2", func(t *testing.T) {
+		builder.SetStrike([2]any{nil, "zigzagzig"})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad ContractSize", func(t *testing.T) {
+		builder.SetContractSize([2]any{10.0, 2.0})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("negative Coupon", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetCoupon([2]any{-1.0, nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("negative ContractSize", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetContractSize([2]any{nil, -1.0})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("negative Strike", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+		builder.SetStrike([2]any{-1.0, nil})
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("NaN bound", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+		builder.SetStrike([2]any{math.NaN(), 2.0})
+		if _, err := builder.Build(); err == nil || !strings.Contains(err.Error(), "NaN") {
+			t.Errorf("Expected a NaN error, got %v", err)
+		}
+		builder.SetStrike([2]any{nil, math.NaN()})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad expiration", func(t *testing.T) {
+		builder.SetExpiration([2]any{123.0, nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad range cleared", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetCoupon([2]any{"zigzagzig", nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+		builder.SetCoupon([2]any{1.0, nil})
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("impossible expiration day", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+		builder.SetExpiration([2]any{"2021-02-30", nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("impossible expiration month", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+		builder.SetExpiration([2]any{nil, "2021-13-01"})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("expiration without option", func(t *testing.T) {
+		builder.SetExpiration([2]any{"2023-01-01", "2024-01-01"})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("optionType without option", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetOptionType("Call")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("strike without option", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetStrike([2]any{1.0, 2.0})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+		builder.SetSecurityType(constants.SECURITYTYPE_EquityOption)
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("expiration under an option securityType", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+		builder.SetExpiration([2]any{"2024-01-01", nil})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+		builder.SetSecurityType(constants.SECURITYTYPE_EquityOption)
+		builder.SetStrike([2]any{1.0, 2.0})
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("maturity without pool", func(t *testing.T) {
+		builder.SetMaturity([2]any{"2023-01-01", "2024-01-01"})
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+}
+
+func TestStrictValidation(t *testing.T) {
+	defer SetStrictValidation(false)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetMarketSecDes(constants.MARKETSECDES_Equity)
+	builder.SetSecurityType2(constants.SECURITYTYPE2_Pool)
+	if _, err := builder.Build(); err != nil {
+		t.Errorf("Unexpected error outside strict mode: %v", err)
+	}
+
+	SetStrictValidation(true)
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	builder.SetMarketSecDes(constants.MARKETSECDES_Mtge)
+	if _, err := builder.Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	coupon := BaseItem{}.GetBuilder()
+	coupon.SetCoupon([2]any{1.0, 2.0})
+	if _, err := coupon.Build(); err == nil || !strings.Contains(err.Error(), "`coupon`") {
+		t.Errorf("Expected a coupon error, got %v", err)
+	}
+	coupon.SetMarketSecDes(constants.MARKETSECDES_Corp)
+	if _, err := coupon.Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	muni := BaseItem{}.GetBuilder()
+	muni.SetStateCodeTyped(constants.STATECODE_CA).SetMarketSecDes(constants.MARKETSECDES_Equity)
+	if _, err := muni.Build(); err == nil || !strings.Contains(err.Error(), "`stateCode`") {
+		t.Errorf("Expected a stateCode error, got %v", err)
+	}
+	muni.SetMarketSecDes(constants.MARKETSECDES_Muni)
+	if _, err := muni.Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateForSearch(t *testing.T) {
+	if err := (BaseItem{}).ValidateForSearch(""); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	if err := (BaseItem{}).ValidateForSearch("IBM"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := (BaseItem{ExchCode: constants.EXCHCODE_US}).ValidateForSearch(""); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetRequireConstraint(true)
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	builder.SetQuery("IBM")
+	if _, err := builder.Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestOptionSearch(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid", func(t *testing.T) {
+		builder := NewOptionSearch(BaseItem{ExchCode: constants.EXCHCODE_US})
+		builder.SetPut().SetStrikeRange(math.Inf(-1), 150)
+		builder.SetExpirationRange(jan, jan.AddDate(0, 3, 0))
+		item, err := builder.Build()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if item.SecurityType2 != "Option" || item.OptionType != "Put" || item.ExchCode != "US" {
+			t.Errorf("Unexpected item: %+v", item)
+		}
+		if *item.Expiration != (interval[string]{"2024-01-01", "2024-04-01"}) {
+			t.Errorf("Unexpected expiration: %v", *item.Expiration)
+		}
+	})
+	t.Run("expiration over a year", func(t *testing.T) {
+		builder := NewOptionSearch(BaseItem{})
+		builder.SetExpirationRange(jan, jan.AddDate(2, 0, 0))
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("bad optionType", func(t *testing.T) {
+		builder := NewOptionSearch(BaseItem{})
+		builder.SetOptionType("Straddle")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("securityType2 overridden", func(t *testing.T) {
+		builder := NewOptionSearch(BaseItem{})
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Pool)
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("chained on the underlying item", func(t *testing.T) {
+		item, err := NewOptionSearch(BaseItem{ExchCode: constants.EXCHCODE_US, Currency: constants.CURRENCY_USD}).
+			SetCall().
+			SetStrikeRange(100, 150).
+			Build()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if item.ExchCode != "US" || item.Currency != "USD" || item.OptionType != "Call" || item.Strike == nil {
+			t.Errorf("Unexpected item: %+v", item)
+		}
+	})
+}
+
+func TestIDTypeIsValid(t *testing.T) {
+	if !IDType(constants.IDTYPE_TICKER).IsValid() {
+		t.Errorf("Expected %s to be valid", constants.IDTYPE_TICKER)
+	}
+	if IDType("zigzagzig").IsValid() {
+		t.Errorf("Expected zigzagzig to be invalid")
+	}
+	if !IDType("id_isin").IsValid() {
+		t.Errorf("Expected id_isin to be valid")
+	}
+}
+
+func TestIDTypeCase(t *testing.T) {
+	builder := MappingItem{}.GetBuilder("id_isin", "US0378331005")
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.Type != constants.IDTYPE_ID_ISIN {
+		t.Errorf("Expected %s, got %s", constants.IDTYPE_ID_ISIN, item.Type)
+	}
+
+	builder = MappingItem{}.GetBuilder("Base_Ticker", "IBM")
+	if _, err := builder.Build(); err == nil || !strings.Contains(err.Error(), "BASE_TICKER") {
+		t.Errorf("Expected securityType2 required for BASE_TICKER, got %v", err)
+	}
+
+	// Sent canonical even when never validated
+	data, err := json.Marshal(MappingItem{Type: "Id_Cusip", Value: "037833100"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), `"idType":"ID_CUSIP"`) {
+		t.Errorf("Expected the canonical idType, got %s", data)
+	}
+}
+
+func TestValidateMappingItem(t *testing.T) {
+	t.Run("bad idType", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder("zigzagzig", "IBM")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("BASE_TICKER without securityType2", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder(constants.IDTYPE_BASE_TICKER, "IBM")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("ID_EXCH_SYMBOL without securityType2", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder(constants.IDTYPE_ID_EXCH_SYMBOL, "IBM")
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("float idValue", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, 1.5)
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("integer idValue for string idType", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, "")
+		builder.SetIntValue(123)
+		if _, err := builder.Build(); err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+	t.Run("integer idValue for numeric idType", func(t *testing.T) {
+		builder := MappingItem{}.GetBuilder(constants.IDTYPE_ID_COMMON, "")
+		builder.SetIntValue(123)
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestBuildMany(t *testing.T) {
+	builder := MappingItem{}.GetBuilder(constants.IDTYPE_TICKER, nil)
+	builder.SetExchCode(constants.EXCHCODE_US)
+
+	req, err := builder.SetValues("AAPL", "MSFT").BuildMany()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(req) != 2 || req[0].Value != "AAPL" || req[1].Value != "MSFT" {
+		t.Fatalf("Expected AAPL and MSFT, got %+v", req)
+	}
+	if req[1].ExchCode != constants.EXCHCODE_US {
+		t.Errorf("Expected shared exchCode, got %q", req[1].ExchCode)
+	}
+
+	_, err = builder.SetValues("AAPL", 1.5).BuildMany()
+	if err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Errorf("Expected an error on item 1, got %v", err)
+	}
+}
+
+func TestCoerceIDValue(t *testing.T) {
+	defer SetCoerceIDValue(false)
+
+	builder := MappingItem{}.GetBuilder(constants.IDTYPE_ID_CUSIP, "")
+	builder.SetIntValue(459200101)
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+
+	SetCoerceIDValue(true)
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	payload, _ := MappingRequest{item, {Type: constants.IDTYPE_ID_COMMON, Value: 123}}.MarshalRequest()
+	if expected := `[{"idType":"ID_CUSIP","idValue":"459200101"},{"idType":"ID_COMMON","idValue":123}]`; string(payload) != expected {
+		t.Errorf("Expected %s, got %s", expected, payload)
+	}
+}
+
+func TestDecodedMappingRequest(t *testing.T) {
+	// Create test server, recording the request bodies
+	var bodies []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mapping", chain(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		mappingHandler(w, r)
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	const data = `[{"idType": "ID_COMMON", "idValue": 123}, {"idType": "TICKER", "idValue": "IBM"}]`
+	var req MappingRequest
+	if err := json.Unmarshal([]byte(data), &req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var numbers MappingRequest
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&numbers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, req := range []MappingRequest{req, numbers} {
+		if _, err := req.Fetch(); err != nil {
+			t.Fatalf("Unexpected error for %T: %v", req[0].Value, err)
+		}
+	}
+	expected := `[{"idType":"ID_COMMON","idValue":123},{"idType":"TICKER","idValue":"IBM"}]`
+	if len(bodies) != 2 || bodies[0] != expected || bodies[1] != expected {
+		t.Errorf("Expected %s sent twice, got %v", expected, bodies)
+	}
+
+	for _, value := range []any{1.5, json.Number("1e3")} {
+		if _, err := (MappingRequest{{Type: constants.IDTYPE_ID_COMMON, Value: value}}).Fetch(); err == nil {
+			t.Errorf("Expected an error for %v", value)
+		}
+	}
+}
+
+func TestSuccessfulBaseItemBuild(t *testing.T) {
+	t.Run("valid 1", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+
+		builder.SetExchCode(constants.EXCHCODE_AU)
+		builder.SetCurrency(constants.CURRENCY_AUD)
+		builder.SetMarketSecDes(constants.MARKETSECDES_Comdty)
+		builder.SetSecurityType(constants.SECURITYTYPE_OPTION)
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+		builder.SetStrike([2]any{2.0, 10.0})
+		builder.SetContractSize([2]any{2.0, 10.0})
+		builder.SetCoupon([2]any{2.0, 10.0})
+		builder.SetExpiration([2]any{"2021-01-01", "2022-01-01"})
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+	t.Run("valid 2", func(t *testing.T) {
+		builder := BaseItem{}.GetBuilder()
+
+		builder.SetMicCode(constants.MICCODE_BMTF)
+		builder.SetSecurityType2(constants.SECURITYTYPE2_Pool)
+		builder.SetMaturity([2]any{"2021-01-01", "2022-01-01"})
+		builder.SetStateCode(constants.STATECODE_AC)
+		if _, err := builder.Build(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestMarshalRequest(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_AU)
+	item, _ := builder.Build()
+
+	payload, err := item.MarshalRequest("AGK", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := `{"exchCode":"AU","query":"AGK"}`; string(payload) != expected {
+		t.Errorf("Expected %s, got %s", expected, payload)
+	}
+
+	map_item, _ := item.AsMappingItem(constants.IDTYPE_TICKER, "IBM")
+	payload, err = MappingRequest{map_item}.MarshalRequest()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := `[{"exchCode":"AU","idType":"TICKER","idValue":"IBM"}]`; string(payload) != expected {
+		t.Errorf("Expected %s, got %s", expected, payload)
+	}
+}
+
+func TestFixedDecimalBounds(t *testing.T) {
+	defer SetFixedDecimalBounds(false)
+
+	strike := interval[float64]{0.0001, 5e20}
+	coupon := interval[float64]{1e-7, math.Inf(1)}
+	for _, tt := range []struct {
+		fixed          bool
+		strike, coupon string
+	}{
+		{false, `[0.0001,500000000000000000000]`, `[1e-7,null]`},
+		{true, `[0.0001,500000000000000000000]`, `[0.0000001,null]`},
+	} {
+		SetFixedDecimalBounds(tt.fixed)
+		for got, want := range map[*interval[float64]]string{&strike: tt.strike, &coupon: tt.coupon} {
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(data) != want {
+				t.Errorf("Expected %s with fixed %v, got %s", want, tt.fixed, data)
+			}
+		}
+	}
+}
+
+// Whether the decoded interval means the same as the original: the same open
+// bounds, whatever their sign, and the same closed ones
+func sameInterval[T constraints.Ordered](original, decoded interval[T]) bool {
+	for i := range original {
+		if isOpenBound(original[i]) != isOpenBound(decoded[i]) ||
+			!isOpenBound(original[i]) && original[i] != decoded[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func FuzzIntervalMarshalUnmarshal(f *testing.F) {
+	f.Add(1.5, 2.5, "2024-01-01", "", false)
+	f.Add(math.Inf(1), math.Inf(-1), "", "2024-12-31", true)
+	f.Add(1e-7, 5e20, "\"", "\\u0000", true)
+	f.Add(math.NaN(), 0.0, "<>&", "é", false)
+	f.Fuzz(func(t *testing.T, low, high float64, from, to string, fixed bool) {
+		SetFixedDecimalBounds(fixed)
+		defer SetFixedDecimalBounds(false)
+
+		numbers := interval[float64]{low, high}
+		data, err := json.Marshal(numbers)
+		if math.IsNaN(low) || math.IsNaN(high) {
+			if err == nil {
+				t.Fatalf("Expected a NaN error, got %s", data)
+			}
+		} else {
+			var decoded interval[float64]
+			if err != nil {
+				t.Fatalf("Unexpected error marshaling %v: %v", numbers, err)
+			}
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unexpected error unmarshaling %s: %v", data, err)
+			}
+			if !sameInterval(numbers, decoded) {
+				t.Errorf("Expected %v, got %v from %s", numbers, decoded, data)
+			}
+		}
+
+		// encoding/json replaces invalid UTF-8
+		if !utf8.ValidString(from) || !utf8.ValidString(to) {
+			return
+		}
+		dates := interval[string]{from, to}
+		data, err = json.Marshal(dates)
+		if err != nil {
+			t.Fatalf("Unexpected error marshaling %q: %v", dates, err)
+		}
+		var decoded interval[string]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unexpected error unmarshaling %s: %v", data, err)
+		}
+		if decoded != dates {
+			t.Errorf("Expected %q, got %q from %s", dates, decoded, data)
+		}
+	})
+}
+
+func FuzzIntervalUnmarshal(f *testing.F) {
+	f.Add([]byte(`[1, null]`))
+	f.Add([]byte(`["2024-01-01", "2024-06-01"]`))
+	f.Add([]byte(`[null, null, 3]`))
+	f.Add([]byte(`[1e400]`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Arbitrary input must not panic, and what decodes must encode back the same
+		var numbers interval[float64]
+		if json.Unmarshal(data, &numbers) == nil {
+			encoded, err := json.Marshal(numbers)
+			if err != nil {
+				t.Fatalf("Unexpected error marshaling %v from %s: %v", numbers, data, err)
+			}
+			var decoded interval[float64]
+			if err := json.Unmarshal(encoded, &decoded); err != nil || !sameInterval(numbers, decoded) {
+				t.Errorf("Expected %v, got %v (%v)", numbers, decoded, err)
+			}
+		}
+		var dates interval[string]
+		if json.Unmarshal(data, &dates) == nil {
+			encoded, err := json.Marshal(dates)
+			if err != nil {
+				t.Fatalf("Unexpected error marshaling %q from %s: %v", dates, data, err)
+			}
+			var decoded interval[string]
+			if err := json.Unmarshal(encoded, &decoded); err != nil || decoded != dates {
+				t.Errorf("Expected %q, got %q (%v)", dates, decoded, err)
+			}
+		}
+	})
+}
+
+func TestValidateRequestJSON(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+	builder.SetStrike([2]any{nil, 2.0})
+	builder.SetExpiration([2]any{"2021-01-01", nil})
+	item, _ := builder.Build()
+	if err := ValidateRequestJSON(item); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	item.Strike = &interval[float64]{math.NaN(), 2.0}
+	if err := ValidateRequestJSON(item); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestConversionDeepCopy(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+	builder.SetStrike([2]any{2.0, 10.0})
+	item, _ := builder.Build()
+
+	map_item, err := item.AsMappingItem(constants.IDTYPE_TICKER, "IBM")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	base_item, err := map_item.AsBaseItem()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Mutate the source in place
+	item.Strike[1] = 20.0
+	builder.item.Strike[0] = 5.0
+	if map_item.Strike[0] != 2.0 || map_item.Strike[1] != 10.0 {
+		t.Errorf("Expected strike to be [2 10], got %v", *map_item.Strike)
+	}
+
+	map_item.Strike[1] = 30.0
+	if base_item.Strike[1] != 10.0 {
+		t.Errorf("Expected strike to be [2 10], got %v", *base_item.Strike)
+	}
+}
+
+func TestGeneratedCounts(t *testing.T) {
+	if constants.ExchCodeCount() != exchCodeSet.Len() {
+		t.Errorf("Expected %d exchCode constants, got %d", exchCodeSet.Len(), constants.ExchCodeCount())
+	}
+	if constants.IDTypeCount() != idTypeSet.Len() {
+		t.Errorf("Expected %d idType constants, got %d", idTypeSet.Len(), constants.IDTypeCount())
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	objs := []FIGIObject{
+		{FIGI: "A", ExchangeCode: "US", MarketSector: "Equity"},
+		{FIGI: "B", ExchangeCode: "AU", MarketSector: "Equity"},
+		{FIGI: "C", ExchangeCode: "US", MarketSector: "Corp"},
+	}
+
+	byExchange := GroupByExchange(objs)
+	if len(byExchange["US"]) != 2 || byExchange["US"][0].FIGI != "A" || byExchange["US"][1].FIGI != "C" {
+		t.Errorf("Expected US group [A C], got %v", byExchange["US"])
+	}
+	if len(byExchange["AU"]) != 1 {
+		t.Errorf("Expected 1 AU object, got %d", len(byExchange["AU"]))
+	}
+
+	bySector := GroupBySector(objs)
+	if len(bySector) != 2 || len(bySector["Equity"]) != 2 {
+		t.Errorf("Expected 2 sectors with 2 Equity objects, got %v", bySector)
+	}
+}
+
+func TestFIGIObjectEqual(t *testing.T) {
+	tests := []struct {
+		a, b     FIGIObject
+		expected bool
+	}{
+		{FIGIObject{FIGI: "A", Name: "X"}, FIGIObject{FIGI: "A"}, true},
+		{FIGIObject{FIGI: "A", UniqueID: "U"}, FIGIObject{FIGI: "B", UniqueID: "U"}, false},
+		{FIGIObject{UniqueID: "U"}, FIGIObject{FIGI: "A", UniqueID: "U"}, true},
+		{FIGIObject{Ticker: "IBM"}, FIGIObject{Ticker: "IBM"}, true},
+		{FIGIObject{Ticker: "IBM"}, FIGIObject{Ticker: "AAPL"}, false},
+	}
+	for _, test := range tests {
+		if got := test.a.Equal(test.b); got != test.expected {
+			t.Errorf("%+v.Equal(%+v): expected %v, got %v", test.a, test.b, test.expected, got)
+		}
+	}
+}
+
+func TestMappingStats(t *testing.T) {
+	res := []SingleMappingResponse{
+		{Data: []FIGIObject{{FIGI: "A"}}},
+		{Warning: []string{"No identifier found."}},
+		{Error: "Invalid idType"},
+		{Data: []FIGIObject{{FIGI: "B"}}},
+	}
+	mapped, empty, errored := MappingStats(res)
+	if mapped != 2 || empty != 1 || errored != 1 {
+		t.Errorf("Expected 2 mapped, 1 empty and 1 errored, got %d, %d and %d", mapped, empty, errored)
+	}
+}
+
+func TestBaseItemJSONRoundTrip(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_US)
+	builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
+	builder.SetStrike([2]any{nil, 2.0})
+	builder.SetCoupon([2]any{1.0, 2.0})
+	builder.SetExpiration([2]any{"2021-01-01", nil})
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"exchCode":"US","securityType2":"Option","strike":[null,2],"coupon":[1,2],"expiration":["2021-01-01",null]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	var decoded BaseItem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, item) {
+		t.Errorf("Expected %+v, got %+v", item, decoded)
+	}
+}
+
+func TestMappingItemJSONTags(t *testing.T) {
+	tests := []struct {
+		item     BaseItem
+		expected string
+	}{
+		{BaseItem{ExchCode: "US"}, `"exchCode":"US"`},
+		{BaseItem{MicCode: "XNYS"}, `"micCode":"XNYS"`},
+		{BaseItem{Currency: "USD"}, `"currency":"USD"`},
+		{BaseItem{MarketSecDes: "Equity"}, `"marketSecDes":"Equity"`},
+		{BaseItem{SecurityType: "Common Stock"}, `"securityType":"Common Stock"`},
+		{BaseItem{SecurityType2: "Option"}, `"securityType2":"Option"`},
+		{BaseItem{IncludeUnlistedEquities: true}, `"includeUnlistedEquities":true`},
+		{BaseItem{OptionType: "Call"}, `"optionType":"Call"`},
+		{BaseItem{Strike: &interval[float64]{1, 2}}, `"strike":[1,2]`},
+		{BaseItem{ContractSize: &interval[float64]{1, 2}}, `"contractSize":[1,2]`},
+		{BaseItem{Coupon: &interval[float64]{1, 2}}, `"coupon":[1,2]`},
+		{BaseItem{Expiration: &interval[string]{"2021-01-01", ""}}, `"expiration":["2021-01-01",null]`},
+		{BaseItem{Maturity: &interval[string]{"2021-01-01", ""}}, `"maturity":["2021-01-01",null]`},
+		{BaseItem{StateCode: "CA"}, `"stateCode":"CA"`},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(MappingItem{BaseItem: test.item, Type: constants.IDTYPE_TICKER, Value: "IBM"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := "{" + test.expected + `,"idType":"TICKER","idValue":"IBM"}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	}
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetSecurityType2(constants.SECURITYTYPE2_Option).SetOptionType("Straddle")
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected an optionType error, got nil")
+	}
+}
+
+func TestLogValidation(t *testing.T) {
+	var buf strings.Builder
+	SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	defer SetLogger(nil)
+
+	builder := BaseItem{}.GetBuilder()
+	builder.SetCurrency("zigzagzig")
+	builder.Build()
+	if buf.Len() != 0 {
+		t.Errorf("Expected no log by default, got %s", buf.String())
+	}
+
+	SetLogValidation(true)
+	defer SetLogValidation(false)
+	builder.Build()
+	if log := buf.String(); !strings.Contains(log, "field=currency") || !strings.Contains(log, "value=zigzagzig") {
+		t.Errorf("Expected the currency field and value, got %s", log)
+	}
+}
+
+func TestBuilderValidate(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode("zigzagzig")
+	if err := builder.Validate(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+	builder.SetExchCode(constants.EXCHCODE_US)
+	if err := builder.Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	map_builder := MappingItem{}.GetBuilder(constants.IDTYPE_BASE_TICKER, "IBM")
+	if err := map_builder.Validate(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+}
 
+func TestSetVenue(t *testing.T) {
 	builder := BaseItem{}.GetBuilder()
-	builder.SetExchCode(constants.EXCHCODE_AU)
+	builder.SetExchCode(constants.EXCHCODE_US)
+	builder.SetVenue(VenueMIC, constants.MICCODE_BMTF)
 	item, err := builder.Build()
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
+	if item.ExchCode != "" || item.MicCode != constants.MICCODE_BMTF {
+		t.Errorf("Expected only micCode, got %+v", item)
+	}
 
-	res, err := item.Filter("", "")
+	builder.SetVenue(VenueExchange, constants.EXCHCODE_AU)
+	item, err = builder.Build()
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.SearchResponse.Data) == 0 {
-		t.Fatalf("Expected data, got none")
+	if item.ExchCode != constants.EXCHCODE_AU || item.MicCode != "" {
+		t.Errorf("Expected only exchCode, got %+v", item)
 	}
+}
 
-	res, err = res.Next()
+func TestTypedSetters(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCodeTyped(constants.EXCHCODE_US).SetCurrencyTyped(constants.CURRENCY_USD)
+	builder.SetMarketSecDesTyped(constants.MARKETSECDES_Equity).SetSecurityType2Typed(constants.SECURITYTYPE2_CommonStock)
+	item, err := builder.Build()
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.SearchResponse.Data) == 0 {
-		t.Fatalf("Expected data, got none")
+	want := BaseItem{
+		ExchCode:      constants.EXCHCODE_US,
+		Currency:      constants.CURRENCY_USD,
+		MarketSecDes:  constants.MARKETSECDES_Equity,
+		SecurityType2: constants.SECURITYTYPE2_CommonStock,
+	}
+	if !reflect.DeepEqual(item, want) {
+		t.Errorf("Expected %+v, got %+v", want, item)
 	}
+}
 
-	res, err = res.Next()
+func TestSetFields(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	err := builder.SetFields(map[string]any{
+		"exchCode":                constants.EXCHCODE_US,
+		"securityType2":           "Option",
+		"includeUnlistedEquities": true,
+		"strike":                  []any{100, nil},
+		"expiration":              [2]any{"2024-01-01", "2024-06-01"},
+	})
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
-	if len(res.SearchResponse.Data) != 0 {
-		t.Fatalf("Expected no data, got %d", len(res.SearchResponse.Data))
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
 	}
-	if res.Total != 1589028 {
-		t.Errorf("Expected total to be 1589028, got %d", res.Total)
+	if item.ExchCode != constants.EXCHCODE_US || !item.IncludeUnlistedEquities ||
+		item.Strike == nil || item.Strike[0] != 100 || item.Expiration == nil {
+		t.Errorf("Unexpected item %+v", item)
 	}
-}
 
-func TestValidateBaseItem(t *testing.T) {
-	builder := BaseItem{}.GetBuilder()
+	if err := builder.SetFields(map[string]any{"coupon": [2]any{uint8(1), 2.5}}); err != nil {
+		t.Errorf("Unexpected error for integer bounds: %v", err)
+	}
+	if err := builder.SetFields(map[string]any{"maturity": []any{2024, nil}}); err == nil {
+		t.Errorf("Expected an error for an integer date bound")
+	}
 
-	t.Run("exchCode and micCode", func(t *testing.T) {
-		builder.SetExchCode(constants.EXCHCODE_AU)
-		builder.SetMicCode(constants.MICCODE_ADRK)
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad exchCode", func(t *testing.T) {
-		builder.SetExchCode("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad micCode", func(t *testing.T) {
-		builder.SetMicCode("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad currency", func(t *testing.T) {
-		builder.SetCurrency("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad marketSecDes", func(t *testing.T) {
-		builder.SetMarketSecDes("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad securityType", func(t *testing.T) {
-		builder.SetSecurityType("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad securityType2", func(t *testing.T) {
-		builder.SetSecurityType2("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad stateCode", func(t *testing.T) {
-		builder.SetStateCode("zigzagzig")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad Strike 1", func(t *testing.T) {
-		builder.SetStrike([2]any{nil, nil})
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad Strike 2", func(t *testing.T) {
-		shouldPanic(t, func() {
-			builder.SetStrike([2]any{nil, "zigzagzig"})
-		})
-	})
-	t.Run("bad ContractSize", func(t *testing.T) {
-		builder.SetContractSize([2]any{10.0, 2.0})
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("bad expiration", func(t *testing.T) {
-		shouldPanic(t, func() {
-			builder.SetExpiration([2]any{123.0, nil})
-		})
-	})
-	t.Run("expiration without option", func(t *testing.T) {
-		builder.SetExpiration([2]any{"2023-01-01", "2024-01-01"})
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
+	err = builder.SetFields(map[string]any{
+		"currency": 1,
+		"exchange": "US",
+		"coupon":   []any{1.0},
+		"maturity": "2024",
 	})
-	t.Run("maturity without pool", func(t *testing.T) {
-		builder.SetMaturity([2]any{"2023-01-01", "2024-01-01"})
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
+	for _, want := range []string{"`coupon` must have 2 bounds", "`currency` must be a string", "unknown field `exchange`", "`maturity` must be a range"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected %q in %v", want, err)
 		}
-	})
+	}
 }
 
-func TestValidateMappingItem(t *testing.T) {
-	t.Run("bad idType", func(t *testing.T) {
-		builder := MappingItem{}.GetBuilder("zigzagzig", "IBM")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("BASE_TICKER without securityType2", func(t *testing.T) {
-		builder := MappingItem{}.GetBuilder(constants.IDTYPE_BASE_TICKER, "IBM")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
-	t.Run("ID_EXCH_SYMBOL without securityType2", func(t *testing.T) {
-		builder := MappingItem{}.GetBuilder(constants.IDTYPE_ID_EXCH_SYMBOL, "IBM")
-		if _, err := builder.Build(); err == nil {
-			t.Errorf("Expected error, got nil")
-		}
-	})
+func TestUnset(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetExchCode(constants.EXCHCODE_US).SetMicCode(constants.MICCODE_BMTF)
+	builder.SetMaturity([2]any{"2021-01-01", nil})
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
+
+	builder.UnsetExchCode().UnsetMaturity()
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.ExchCode != "" || item.Maturity != nil || item.MicCode != constants.MICCODE_BMTF {
+		t.Errorf("Unexpected item: %+v", item)
+	}
 }
 
-func TestSuccessfulBaseItemBuild(t *testing.T) {
-	t.Run("valid 1", func(t *testing.T) {
-		builder := BaseItem{}.GetBuilder()
+func TestNormalization(t *testing.T) {
+	builder := BaseItem{}.GetBuilder()
+	builder.SetNormalization(true)
+	builder.SetExchCode(" au").SetCurrency("aud ")
+	item, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item.ExchCode != constants.EXCHCODE_AU {
+		t.Errorf("Expected exchCode to be AU, got %q", item.ExchCode)
+	}
+	if item.Currency != constants.CURRENCY_AUD {
+		t.Errorf("Expected currency to be AUD, got %q", item.Currency)
+	}
 
-		builder.SetExchCode(constants.EXCHCODE_AU)
-		builder.SetCurrency(constants.CURRENCY_AUD)
-		builder.SetMarketSecDes(constants.MARKETSECDES_Comdty)
-		builder.SetSecurityType(constants.SECURITYTYPE_OPTION)
-		builder.SetSecurityType2(constants.SECURITYTYPE2_Option)
-		builder.SetStrike([2]any{2.0, 10.0})
-		builder.SetContractSize([2]any{2.0, 10.0})
-		builder.SetCoupon([2]any{2.0, 10.0})
-		builder.SetExpiration([2]any{"2021-01-01", "2022-01-01"})
-		if _, err := builder.Build(); err != nil {
-			t.Errorf("Unexpected error: %v", err)
-		}
-	})
-	t.Run("valid 2", func(t *testing.T) {
-		builder := BaseItem{}.GetBuilder()
+	// Known mixed-case values are kept
+	builder.SetCurrency("GBp")
+	if item, _ := builder.Build(); item.Currency != "GBp" {
+		t.Errorf("Expected currency to be GBp, got %q", item.Currency)
+	}
 
-		builder.SetMicCode(constants.MICCODE_BMTF)
-		builder.SetSecurityType2(constants.SECURITYTYPE2_Pool)
-		builder.SetMaturity([2]any{"2021-01-01", "2022-01-01"})
-		builder.SetStateCode(constants.STATECODE_AC)
-		if _, err := builder.Build(); err != nil {
-			t.Errorf("Unexpected error: %v", err)
-		}
-	})
+	builder.SetNormalization(false)
+	if _, err := builder.Build(); err == nil {
+		t.Errorf("Expected error, got nil")
+	}
 }
 
 // === HANDLERs ===
@@ -388,7 +3053,7 @@ func mappingHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func searchHandler(w http.ResponseWriter, r *http.Request) {
-	payload, err := jsonDecode[searchOrFilterRequest](r)
+	payload, err := jsonDecode[SearchRequest](r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -417,7 +3082,7 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func filterHandler(w http.ResponseWriter, r *http.Request) {
-	payload, err := jsonDecode[searchOrFilterRequest](r)
+	payload, err := jsonDecode[SearchRequest](r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		return
@@ -458,3 +3123,202 @@ func filterHandler(w http.ResponseWriter, r *http.Request) {
 	res, _ := json.Marshal(filterRes)
 	w.Write(res)
 }
+
+func TestCollectReport(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/filter", chain(filterHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	res, err := BaseItem{ExchCode: constants.EXCHCODE_AU}.Filter("", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	report, err := res.CollectReport(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.Total != 1589028 || report.Pages != 3 || len(report.Objects) != 200 {
+		t.Errorf("Expected total 1589028 over 3 pages with 200 objects, got %d over %d with %d",
+			report.Total, report.Pages, len(report.Objects))
+	}
+
+	report, err = res.CollectReport(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.Pages != 2 || len(report.Objects) != 200 {
+		t.Errorf("Expected 2 pages with 200 objects, got %d with %d", report.Pages, len(report.Objects))
+	}
+
+	// The same page twice is deduplicated
+	twice := FilterResponse{SearchResponse: SearchResponse{Data: append(slices.Clone(res.Data), res.Data...)}}
+	report, err = twice.CollectReport(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if report.Pages != 1 || len(report.Objects) != len(res.Data) {
+		t.Errorf("Expected %d objects, got %d", len(res.Data), len(report.Objects))
+	}
+}
+
+func TestDedup(t *testing.T) {
+	objs := []FIGIObject{
+		{FIGI: "BBG000BLNNH6", Name: "first"},
+		{FIGI: "BBG000BLNNH6", Name: "second"},
+		{UniqueID: "EQ0010080100001000"},
+		{UniqueID: "EQ0010080100001000", Name: "other"},
+		{Name: "INTL BUSINESS MACHINES CORP"},
+		{Name: "INTL BUSINESS MACHINES CORP"},
+		{Name: "IBM"},
+		{FIGI: "BBG000BLNNJ4", UniqueID: "EQ0010080100001000"},
+		{FIGI: "BBG000BLNNK3", UniqueID: "EQ0010080100001001"},
+		{UniqueID: "EQ0010080100001001"},
+	}
+	want := []FIGIObject{objs[0], objs[2], objs[4], objs[6], objs[8]}
+	// Every object removed is Equal to one kept
+	for _, obj := range objs {
+		if !slices.ContainsFunc(want, obj.Equal) {
+			t.Errorf("Expected %v to be Equal to a kept object", obj)
+		}
+	}
+	got := dedup(objs)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestContinueOnEmptyPage(t *testing.T) {
+	// Create test server: an empty page between two full ones, from "loop"
+	// empty pages forever, and from "full" more non-empty pages than the cap
+	// of empty ones
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		payload, err := jsonDecode[SearchRequest](r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch payload.Start {
+		case "":
+			w.Write([]byte(`{"data": [{"figi": "BBG000BLNNH6"}], "next": "empty"}`))
+		case "empty":
+			w.Write([]byte(`{"data": [], "next": "last"}`))
+		case "last":
+			w.Write([]byte(`{"data": [{"figi": "BBG000BLNNJ4"}]}`))
+		case "full":
+			if calls.Load() < 2*MaxConsecutiveEmptyPages {
+				w.Write([]byte(`{"data": [{"figi": "BBG000BLNNH6"}], "next": "full"}`))
+			} else {
+				w.Write([]byte(`{"data": [{"figi": "BBG000BLNNH6"}]}`))
+			}
+		default:
+			w.Write([]byte(`{"data": [], "next": "loop"}`))
+		}
+	}, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+	ctx := context.Background()
+	item := BaseItem{ExchCode: constants.EXCHCODE_US}
+
+	objs, err := item.All(ctx, "IBM", CollectOptions{})
+	if err != nil || len(objs) != 1 {
+		t.Errorf("Expected to stop at the empty page, got %d objects (%v)", len(objs), err)
+	}
+	objs, err = item.All(ctx, "IBM", CollectOptions{ContinueOnEmptyPage: true})
+	if err != nil || len(objs) != 2 {
+		t.Errorf("Expected to continue past the empty page, got %d objects (%v)", len(objs), err)
+	}
+
+	var streamed int
+	res, err := item.Search("IBM", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for r := range res.Stream(ctx, CollectOptions{ContinueOnEmptyPage: true}) {
+		if r.Err != nil {
+			t.Fatalf("Unexpected error: %v", r.Err)
+		}
+		streamed++
+	}
+	if streamed != 2 {
+		t.Errorf("Expected 2 streamed objects, got %d", streamed)
+	}
+
+	loop := SearchResponse{baseitem: item, NextHash: "loop"}
+	calls.Store(0)
+	if _, err := loop.CollectN(ctx, 5, CollectOptions{ContinueOnEmptyPage: true}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("Expected 4 more pages under the cap of 5, got %d", calls.Load())
+	}
+	calls.Store(0)
+	if _, err := loop.Collect(ctx, CollectOptions{ContinueOnEmptyPage: true}); !errors.Is(err, ErrTooManyEmptyPages) {
+		t.Errorf("Expected ErrTooManyEmptyPages, got %v", err)
+	}
+	if calls.Load() != MaxConsecutiveEmptyPages-1 {
+		t.Errorf("Expected %d more pages under the cap of empty pages, got %d", MaxConsecutiveEmptyPages-1, calls.Load())
+	}
+	var streamErr error
+	for r := range loop.Stream(ctx, CollectOptions{ContinueOnEmptyPage: true}) {
+		streamErr = r.Err
+	}
+	if !errors.Is(streamErr, ErrTooManyEmptyPages) {
+		t.Errorf("Expected ErrTooManyEmptyPages from Stream, got %v", streamErr)
+	}
+
+	full := SearchResponse{baseitem: item, NextHash: "full"}
+	calls.Store(0)
+	objs, err = full.Collect(ctx, CollectOptions{ContinueOnEmptyPage: true})
+	if err != nil || len(objs) != 2*MaxConsecutiveEmptyPages {
+		t.Errorf("Expected %d objects past the cap of empty pages, got %d (%v)", 2*MaxConsecutiveEmptyPages, len(objs), err)
+	}
+}
+
+func TestRestart(t *testing.T) {
+	// Create test server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/search", chain(searchHandler, method("POST"), jsonContentType()))
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	SetAPIBaseUrl(ts.URL)
+
+	item := BaseItem{ExchCode: constants.EXCHCODE_AU}
+	first, err := item.Search("IBM", FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	next, err := first.Next()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fresh := next.Restart()
+	if fresh.NextHash != "" || fresh.Data != nil || fresh.Header() != nil || fresh.PageSize() != 0 {
+		t.Errorf("Expected no pagination state, got %+v", fresh)
+	}
+	if fresh.Item() != item || fresh.Query() != "IBM" {
+		t.Errorf("Expected the item and query kept, got %+v and %q", fresh.Item(), fresh.Query())
+	}
+	if _, err := fresh.Next(); !errors.Is(err, ErrNoMoreResults) {
+		t.Errorf("Expected no cursor to follow, got %v", err)
+	}
+
+	again, err := fresh.Item().Search(fresh.Query(), FirstPage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if again.NextHash != first.NextHash || len(again.Data) != len(first.Data) {
+		t.Errorf("Expected the first page again, got next %q", again.NextHash)
+	}
+}
@@ -0,0 +1,87 @@
+package openfigi
+
+import (
+	"fmt"
+	"time"
+)
+
+// ========================= OPTION SEARCH =========================
+
+// Builder of option searches, with `securityType2` set to `Option`.
+// Fields other than the option ones are set on the underlying item given to
+// [NewOptionSearch].
+type OptionSearchBuilder struct {
+	BaseItemBuilder
+}
+
+// Option search on the venue, currency... of underlying
+//
+// Usage:
+//
+//	builder := NewOptionSearch(BaseItem{ExchCode: "US"})
+//	builder.SetCall().SetStrikeRange(100, 150)
+//	builder.SetExpirationRange(time.Now(), time.Now().AddDate(0, 3, 0))
+//	item, err := builder.Build()
+func NewOptionSearch(underlying BaseItem) *OptionSearchBuilder {
+	b := &OptionSearchBuilder{BaseItemBuilder{item: underlying.clone()}}
+	b.item.SecurityType2 = "Option"
+	return b
+}
+
+func (b *OptionSearchBuilder) SetCall() *OptionSearchBuilder {
+	b.item.OptionType = "Call"
+	return b
+}
+
+func (b *OptionSearchBuilder) SetPut() *OptionSearchBuilder {
+	b.item.OptionType = "Put"
+	return b
+}
+
+// Strike in [low, high]. Use math.Inf(-1) or math.Inf(1) for an open bound.
+func (b *OptionSearchBuilder) SetStrikeRange(low, high float64) *OptionSearchBuilder {
+	b.item.Strike = &interval[float64]{low, high}
+	return b
+}
+
+// Expiration in [from, to], at most 1 year apart. Use the zero time for an open bound.
+func (b *OptionSearchBuilder) SetExpirationRange(from, to time.Time) *OptionSearchBuilder {
+	expiration := interval[string]{}
+	if !from.IsZero() {
+		expiration[0] = from.Format(time.DateOnly)
+	}
+	if !to.IsZero() {
+		expiration[1] = to.Format(time.DateOnly)
+	}
+	b.item.Expiration = &expiration
+	return b
+}
+
+// Build the item, checking the option rules on top of the usual validation
+func (b *OptionSearchBuilder) Build() (item BaseItem, err error) {
+	item, err = b.BaseItemBuilder.Build()
+	if err != nil {
+		return
+	}
+	defer func() { logValidationErr(item, err) }()
+
+	if item.SecurityType2 != "Option" {
+		err = fmt.Errorf("`securityType2` must be `Option` for an option search, got %q", item.SecurityType2)
+		return
+	}
+	if exp := item.Expiration; exp != nil && exp[0] != "" && exp[1] != "" {
+		from, _ := parseDate(exp[0])
+		to, _ := parseDate(exp[1])
+		if to.After(from.AddDate(1, 0, 0)) {
+			err = fmt.Errorf("`expiration` bounds must be at most 1 year apart, got %s to %s", exp[0], exp[1])
+			return
+		}
+	}
+	return
+}
+
+// Run the checks of Build() on the search so far, without building it
+func (b *OptionSearchBuilder) Validate() error {
+	_, err := b.Build()
+	return err
+}
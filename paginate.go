@@ -0,0 +1,333 @@
+package openfigi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Options of the auto-pagination with Collect() and All()
+type CollectOptions struct {
+	// Deadline of each page fetch, derived from the parent context.
+	// Default 0, pages are only bounded by the parent context.
+	PerPageTimeout time.Duration
+	// Keep following `next` past pages with empty data, which the API may
+	// return under sparse filters before further results. Default false,
+	// stopping at the first empty page.
+	//
+	// An API that keeps returning empty pages with a `next` would be followed
+	// forever, so after [MaxConsecutiveEmptyPages] empty pages in a row,
+	// pagination stops with [ErrTooManyEmptyPages]. Non-empty pages reset the
+	// count and are only bounded by maxPages of CollectN.
+	ContinueOnEmptyPage bool
+}
+
+// Empty pages in a row followed under [CollectOptions.ContinueOnEmptyPage]
+// before giving up with [ErrTooManyEmptyPages]
+const MaxConsecutiveEmptyPages = 100
+
+// Whether pagination stops after res: on the last page, or on an empty one
+// unless ContinueOnEmptyPage
+func (opts CollectOptions) done(res SearchResponse) bool {
+	if opts.ContinueOnEmptyPage {
+		return res.NextHash == ""
+	}
+	return res.Done()
+}
+
+// Count res in the run of empty pages, erring once ContinueOnEmptyPage has
+// reached [MaxConsecutiveEmptyPages] of them with more to follow
+func (opts CollectOptions) checkEmptyRun(res SearchResponse, run *int) error {
+	if len(res.Data) > 0 {
+		*run = 0
+		return nil
+	}
+	*run++
+	if opts.ContinueOnEmptyPage && *run >= MaxConsecutiveEmptyPages && res.NextHash != "" {
+		return fmt.Errorf("%w: %d in a row", ErrTooManyEmptyPages, *run)
+	}
+	return nil
+}
+
+// Context of a single page fetch, bounded by PerPageTimeout
+func (opts CollectOptions) pageContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if opts.PerPageTimeout > 0 {
+		return context.WithTimeout(ctx, opts.PerPageTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// A page of [SearchResponse] or [FilterResponse]
+type page[T any] interface {
+	searchResponse() SearchResponse
+	next(ctx context.Context) (T, error)
+}
+
+func (searchRes SearchResponse) searchResponse() SearchResponse {
+	return searchRes
+}
+
+func (searchRes SearchResponse) next(ctx context.Context) (SearchResponse, error) {
+	if searchRes.NextHash == "" {
+		return SearchResponse{}, ErrNoMoreResults
+	}
+	next, err := searchRes.baseitem.search(ctx, searchRes.query, searchRes.NextHash)
+	next.largestPage = searchRes.largestPageSize()
+	return next, err
+}
+
+func (filterRes FilterResponse) next(ctx context.Context) (FilterResponse, error) {
+	if filterRes.NextHash == "" {
+		return FilterResponse{}, ErrNoMoreResults
+	}
+	next, err := filterRes.baseitem.filter(ctx, filterRes.query, filterRes.NextHash)
+	next.largestPage = filterRes.largestPageSize()
+	return next, err
+}
+
+// Data of this page and every following one, until a page is empty (see
+// [CollectOptions.ContinueOnEmptyPage]) or the last.
+// On error, returns the data collected so far and an error naming the failed page,
+// counting this page as page 1. Once ctx is done, returns the data so far and
+// ctx.Err() without fetching another page.
+//
+// Usage:
+//
+//	res, _ := item.Search("CRYP", FirstPage)
+//	objs, err := res.Collect(ctx, CollectOptions{PerPageTimeout: 10 * time.Second})
+func (searchRes SearchResponse) Collect(ctx context.Context, opts CollectOptions) ([]FIGIObject, error) {
+	return collect(ctx, searchRes, 0, opts)
+}
+
+// [SearchResponse.Collect] stopping after maxPages pages, this one included
+// (0 for no limit)
+func (searchRes SearchResponse) CollectN(ctx context.Context, maxPages int, opts CollectOptions) ([]FIGIObject, error) {
+	return collect(ctx, searchRes, maxPages, opts)
+}
+
+// Data of this page and every following one, see [SearchResponse.Collect]
+func (filterRes FilterResponse) Collect(ctx context.Context, opts CollectOptions) ([]FIGIObject, error) {
+	return collect(ctx, filterRes, 0, opts)
+}
+
+// [FilterResponse.Collect] stopping after maxPages pages, this one included
+// (0 for no limit)
+func (filterRes FilterResponse) CollectN(ctx context.Context, maxPages int, opts CollectOptions) ([]FIGIObject, error) {
+	return collect(ctx, filterRes, maxPages, opts)
+}
+
+// Data of this page and every following one, from the endpoint of this page,
+// see [SearchResponse.Collect]
+func (res SearchOrFilterResponse) Collect(ctx context.Context, opts CollectOptions) ([]FIGIObject, error) {
+	return res.CollectN(ctx, 0, opts)
+}
+
+// [SearchOrFilterResponse.Collect] stopping after maxPages pages, this one
+// included (0 for no limit)
+func (res SearchOrFilterResponse) CollectN(ctx context.Context, maxPages int, opts CollectOptions) ([]FIGIObject, error) {
+	if filterRes, ok := res.asFilter(); ok {
+		return collect(ctx, filterRes, maxPages, opts)
+	}
+	return collect(ctx, res.SearchResponse, maxPages, opts)
+}
+
+// Every search result of item and query, from the first page
+//
+// Usage:
+//
+//	objs, err := item.All(ctx, "CRYP", CollectOptions{PerPageTimeout: 10 * time.Second})
+func (item BaseItem) All(ctx context.Context, query string, opts CollectOptions) ([]FIGIObject, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	pageCtx, cancel := opts.pageContext(ctx)
+	res, err := item.search(pageCtx, query, FirstPage)
+	cancel()
+	if err != nil {
+		return nil, pageError(ctx, 1, opts, err)
+	}
+	return res.Collect(ctx, opts)
+}
+
+func collect[T page[T]](ctx context.Context, res T, maxPages int, opts CollectOptions) ([]FIGIObject, error) {
+	data, _, _, err := collectPages(ctx, res, maxPages, opts)
+	return data, err
+}
+
+// Data of res and the pages following it as by [SearchResponse.CollectN], with
+// the last page fetched and the number of pages traversed, res included
+func collectPages[T page[T]](ctx context.Context, res T, maxPages int, opts CollectOptions) (data []FIGIObject, last T, pages int, err error) {
+	data = slices.Clone(res.searchResponse().Data)
+	var emptyRun int
+	for pages = 1; !opts.done(res.searchResponse()); pages++ {
+		pageNum := pages + 1
+		if maxPages > 0 && pageNum > maxPages {
+			break
+		}
+		if err := opts.checkEmptyRun(res.searchResponse(), &emptyRun); err != nil {
+			return data, res, pages, err
+		}
+		if err := ctx.Err(); err != nil {
+			return data, res, pages, err
+		}
+		pageCtx, cancel := opts.pageContext(ctx)
+		next, err := res.next(pageCtx)
+		cancel()
+		if err != nil {
+			return data, res, pages, pageError(ctx, pageNum, opts, err)
+		}
+		res = next
+		data = append(data, res.searchResponse().Data...)
+	}
+	return data, res, pages, nil
+}
+
+// Outcome of a filter scan by [FilterResponse.CollectReport]
+type Report struct {
+	Total   int          // Total of the last page fetched
+	Pages   int          // Pages traversed, the starting one included
+	Objects []FIGIObject // Objects of every page, without duplicates
+}
+
+// Total, number of pages and deduplicated objects of this page and every
+// following one, stopping after maxPages pages (0 for no limit), as by
+// [FilterResponse.CollectN]. Objects are duplicates when [FIGIObject.Equal];
+// the first is kept. On error, returns the report so far and an error naming
+// the failed page, as [FilterResponse.Collect] does.
+//
+// Usage:
+//
+//	res, _ := item.Filter("CRYP", FirstPage)
+//	report, err := res.CollectReport(ctx, 0)
+//	fmt.Println(report.Total, report.Pages, len(report.Objects))
+func (filterRes FilterResponse) CollectReport(ctx context.Context, maxPages int) (Report, error) {
+	data, last, pages, err := collectPages(ctx, filterRes, maxPages, CollectOptions{})
+	return Report{Total: last.Total, Pages: pages, Objects: dedup(data)}, err
+}
+
+// objs without duplicates, in place, keeping the first object of each set of
+// objects [FIGIObject.Equal] to it. Indexes the kept objects by the keys Equal
+// compares: FIGI, then UniqueID against objects without a FIGI, then every field.
+func dedup(objs []FIGIObject) []FIGIObject {
+	figis := make(map[string]bool)
+	uniqueIDs := make(map[string]bool)            // Of every kept object
+	uniqueIDsWithoutFIGI := make(map[string]bool) // Of kept objects without a FIGI
+	whole := make(map[FIGIObject]bool)
+	return slices.DeleteFunc(objs, func(obj FIGIObject) bool {
+		var dup bool
+		switch {
+		case obj.FIGI != "":
+			dup = figis[obj.FIGI] || (obj.UniqueID != "" && uniqueIDsWithoutFIGI[obj.UniqueID])
+		case obj.UniqueID != "":
+			dup = uniqueIDs[obj.UniqueID]
+		default:
+			dup = whole[obj]
+		}
+		if dup {
+			return true
+		}
+		if obj.FIGI != "" {
+			figis[obj.FIGI] = true
+		}
+		if obj.UniqueID != "" {
+			uniqueIDs[obj.UniqueID] = true
+			if obj.FIGI == "" {
+				uniqueIDsWithoutFIGI[obj.UniqueID] = true
+			}
+		}
+		whole[obj] = true
+		return false
+	})
+}
+
+// Value or error sent by [SearchResponse.Stream] and [MappingRequest.FetchStream]
+type StreamResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// Objects of this page and every following one, sent as they are fetched.
+// The channel is closed after the last page, after an error naming the failed
+// page, or once ctx is done. To stop reading early, cancel ctx: the producing
+// goroutine then exits without sending anything more.
+//
+// Usage:
+//
+//	ctx, cancel := context.WithCancel(ctx)
+//	defer cancel()
+//	for r := range res.Stream(ctx, CollectOptions{}) {
+//		if r.Err != nil {
+//			return r.Err
+//		}
+//		fmt.Println(r.Value.FIGI)
+//	}
+func (searchRes SearchResponse) Stream(ctx context.Context, opts CollectOptions) <-chan StreamResult[FIGIObject] {
+	return stream(ctx, searchRes, opts)
+}
+
+// Objects of this page and every following one, see [SearchResponse.Stream]
+func (filterRes FilterResponse) Stream(ctx context.Context, opts CollectOptions) <-chan StreamResult[FIGIObject] {
+	return stream(ctx, filterRes, opts)
+}
+
+// Objects of this page and every following one, from the endpoint of this
+// page, see [SearchResponse.Stream]
+func (res SearchOrFilterResponse) Stream(ctx context.Context, opts CollectOptions) <-chan StreamResult[FIGIObject] {
+	if filterRes, ok := res.asFilter(); ok {
+		return stream(ctx, filterRes, opts)
+	}
+	return stream(ctx, res.SearchResponse, opts)
+}
+
+func stream[T page[T]](ctx context.Context, res T, opts CollectOptions) <-chan StreamResult[FIGIObject] {
+	results := make(chan StreamResult[FIGIObject])
+	go func() {
+		defer close(results)
+		var emptyRun int
+		for pageNum := 2; ; pageNum++ {
+			for _, obj := range res.searchResponse().Data {
+				if !sendResult(ctx, results, StreamResult[FIGIObject]{Value: obj}) {
+					return
+				}
+			}
+			if opts.done(res.searchResponse()) {
+				return
+			}
+			if err := opts.checkEmptyRun(res.searchResponse(), &emptyRun); err != nil {
+				sendResult(ctx, results, StreamResult[FIGIObject]{Err: err})
+				return
+			}
+			pageCtx, cancel := opts.pageContext(ctx)
+			next, err := res.next(pageCtx)
+			cancel()
+			if err != nil {
+				if ctx.Err() == nil {
+					sendResult(ctx, results, StreamResult[FIGIObject]{Err: pageError(ctx, pageNum, opts, err)})
+				}
+				return
+			}
+			res = next
+		}
+	}()
+	return results
+}
+
+// Send r unless ctx is done first, reporting whether it was sent
+func sendResult[T any](ctx context.Context, results chan<- StreamResult[T], r StreamResult[T]) bool {
+	select {
+	case results <- r:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Name the page in err, telling a per-page timeout from the parent context's
+func pageError(ctx context.Context, pageNum int, opts CollectOptions, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("page %d: timed out after %v: %w", pageNum, opts.PerPageTimeout, err)
+	}
+	return fmt.Errorf("page %d: %w", pageNum, err)
+}
@@ -0,0 +1,115 @@
+package openfigi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minh-dng/openfigi-go/internal/clock"
+)
+
+// Rate-limit headers of an API response.
+// Limit and Remaining are -1, and Reset is negative, when the header is absent.
+type RateLimit struct {
+	Limit     int           // X-RateLimit-Limit
+	Remaining int           // X-RateLimit-Remaining
+	Reset     time.Duration // X-RateLimit-Reset, time until the quota resets
+}
+
+func parseRateLimit(header http.Header) RateLimit {
+	atoi := func(key string) int {
+		if n, err := strconv.Atoi(header.Get(key)); err == nil {
+			return n
+		}
+		return -1
+	}
+	return RateLimit{
+		Limit:     atoi("X-RateLimit-Limit"),
+		Remaining: atoi("X-RateLimit-Remaining"),
+		Reset:     time.Duration(atoi("X-RateLimit-Reset")) * time.Second,
+	}
+}
+
+// 📊 QUOTA
+var lastRateLimit = mutexStruct[RateLimit]{
+	value: RateLimit{Limit: -1, Remaining: -1, Reset: -time.Second},
+}
+
+func setLastRateLimit(rl RateLimit) {
+	lastRateLimit.Lock()
+	defer lastRateLimit.Unlock()
+	lastRateLimit.value = rl
+}
+
+// Rate-limit headers of the most recent API response
+//
+// Usage:
+//
+//	if rl := LastRateLimit(); rl.Remaining == 0 {
+//		time.Sleep(rl.Reset)
+//	}
+func LastRateLimit() RateLimit {
+	lastRateLimit.RLock()
+	defer lastRateLimit.RUnlock()
+	return lastRateLimit.value
+}
+
+// 🚦 RATE LIMITER
+
+// Paces the requests, e.g. a *rate.Limiter from golang.org/x/time/rate.
+// Wait blocks until a request may be sent, or errors when ctx is done first.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+var sharedLimiter mutexStruct[RateLimiter]
+
+// Limiter every request waits on before being sent, retries included.
+// Pass the same limiter to every process component sharing an API key,
+// so they share its quota. nil, the default, disables it.
+//
+// Usage:
+//
+//	limiter := rate.NewLimiter(rate.Every(time.Minute/25), 1) // 25 requests a minute
+//	SetSharedRateLimiter(limiter)
+func SetSharedRateLimiter(limiter RateLimiter) {
+	sharedLimiter.Lock()
+	defer sharedLimiter.Unlock()
+	sharedLimiter.value = limiter
+}
+
+// Wait on the shared limiter, if any
+func waitRateLimiter(ctx context.Context) error {
+	sharedLimiter.RLock()
+	limiter := sharedLimiter.value
+	sharedLimiter.RUnlock()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+// [RateLimiter] spacing requests evenly, at most perMinute a minute
+type intervalLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time // Earliest time the next request may be sent
+}
+
+func newIntervalLimiter(perMinute int) *intervalLimiter {
+	return &intervalLimiter{interval: time.Minute / time.Duration(perMinute)}
+}
+
+func (l *intervalLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := clock.Get().Now()
+	at := now
+	if l.next.After(at) {
+		at = l.next
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+	return sleepContext(ctx, at.Sub(now))
+}
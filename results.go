@@ -0,0 +1,49 @@
+package openfigi
+
+// ========================= POST-PROCESSING =========================
+
+// Group objects by key, keeping their order within each group
+//
+// Usage:
+//
+//	byTicker := GroupBy(res[0].Data, func(o FIGIObject) string { return o.Ticker })
+func GroupBy(objs []FIGIObject, key func(FIGIObject) string) map[string][]FIGIObject {
+	groups := make(map[string][]FIGIObject)
+	for _, obj := range objs {
+		k := key(obj)
+		groups[k] = append(groups[k], obj)
+	}
+	return groups
+}
+
+// Group objects by exchange code
+func GroupByExchange(objs []FIGIObject) map[string][]FIGIObject {
+	return GroupBy(objs, func(o FIGIObject) string { return o.ExchangeCode })
+}
+
+// Group objects by market sector
+func GroupBySector(objs []FIGIObject) map[string][]FIGIObject {
+	return GroupBy(objs, func(o FIGIObject) string { return o.MarketSector })
+}
+
+// Count the mapping jobs that mapped (some data, no error), came back empty
+// (no data, no error, e.g. "No identifier found.") or errored
+//
+// Usage:
+//
+//	res, err := req.FetchAll(ctx)
+//	mapped, empty, errored := MappingStats(res)
+//	slog.Info("mapped", "mapped", mapped, "empty", empty, "errored", errored)
+func MappingStats(res []SingleMappingResponse) (mapped, empty, errored int) {
+	for _, job := range res {
+		switch {
+		case job.Error != "":
+			errored++
+		case len(job.Data) == 0:
+			empty++
+		default:
+			mapped++
+		}
+	}
+	return
+}
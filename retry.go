@@ -0,0 +1,72 @@
+package openfigi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/minh-dng/openfigi-go/internal/clock"
+)
+
+// 🔁 RETRIES
+var maxRetries mutexStruct[int]
+
+// Number of times a 429 or 503 response is retried. Default 0 (no retries).
+//
+// The wait between attempts honors the `Retry-After` header (seconds or HTTP-date)
+// and falls back to exponential backoff when it is absent.
+// Waiting stops early when the request context is done.
+func SetMaxRetries(n int) {
+	maxRetries.Lock()
+	defer maxRetries.Unlock()
+	maxRetries.value = max(n, 0)
+}
+
+func MaxRetries() int {
+	maxRetries.RLock()
+	defer maxRetries.RUnlock()
+	return maxRetries.value
+}
+
+// First backoff delay, doubled on every attempt up to maxBackoff
+const (
+	baseBackoff = 500 * time.Millisecond
+	maxBackoff  = 30 * time.Second
+)
+
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// Parse the `Retry-After` header, either delay-seconds or an HTTP-date
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(clock.Get().Now()), 0), true
+	}
+	return 0, false
+}
+
+func backoff(attempt int) time.Duration {
+	if attempt >= 16 {
+		return maxBackoff
+	}
+	return min(baseBackoff<<attempt, maxBackoff)
+}
+
+// Sleep for d on the clock, or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.Get().After(d):
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package openfigi
+
+import (
+	"context"
+	"fmt"
+)
+
+// ========================= SEARCH REQUEST =========================
+
+// Builder of a [SearchRequest]. The item's fields are set on the item given
+// to [NewSearchRequest].
+type SearchRequestBuilder struct {
+	BaseItemBuilder
+	start string
+}
+
+// Search request on item, with its query from [BaseItemBuilder.SetQuery]
+//
+// Usage:
+//
+//	req, err := NewSearchRequest(BaseItem{ExchCode: "US"}).
+//		SetQuery("IBM").
+//		SetStart(savedCursor).
+//		Build()
+//	res, err := req.Do(ctx, APIEndpoints().Filter)
+func NewSearchRequest(item BaseItem) *SearchRequestBuilder {
+	return &SearchRequestBuilder{BaseItemBuilder: BaseItemBuilder{item: item.clone()}}
+}
+
+// Cursor of the page to fetch, e.g. the `next` of a page fetched earlier
+func (b *SearchRequestBuilder) SetStart(start string) *SearchRequestBuilder {
+	b.start = start
+	return b
+}
+
+// Build the request, validating the item as [BaseItemBuilder.Build] does
+func (b *SearchRequestBuilder) Build() (SearchRequest, error) {
+	item, err := b.BaseItemBuilder.Build()
+	if err != nil {
+		return SearchRequest{}, err
+	}
+	return SearchRequest{BaseItem: item, Query: item.Query, Start: b.start}, nil
+}
+
+// Query of the request, see [BaseItemBuilder.SetQuery]
+func (b *SearchRequestBuilder) SetQuery(query string) *SearchRequestBuilder {
+	b.BaseItemBuilder.SetQuery(query)
+	return b
+}
+
+// See [BaseItemBuilder.SetRequireConstraint]
+func (b *SearchRequestBuilder) SetRequireConstraint(require bool) *SearchRequestBuilder {
+	b.BaseItemBuilder.SetRequireConstraint(require)
+	return b
+}
+
+// Send the request to endpoint, [APIEndpoints] Search or Filter.
+// Total is only set by the filter endpoint, and the following pages are
+// fetched from the same endpoint.
+func (req SearchRequest) Do(ctx context.Context, endpoint string) (SearchOrFilterResponse, error) {
+	switch endpoints := APIEndpoints(); endpoint {
+	case endpoints.Search:
+		return req.BaseItem.SearchOrFilter(ctx, req.Query, req.Start, false)
+	case endpoints.Filter:
+		return req.BaseItem.SearchOrFilter(ctx, req.Query, req.Start, true)
+	default:
+		return SearchOrFilterResponse{}, fmt.Errorf("endpoint must be %q or %q, got %q", endpoints.Search, endpoints.Filter, endpoint)
+	}
+}
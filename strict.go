@@ -0,0 +1,59 @@
+package openfigi
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/minh-dng/openfigi-go/constants"
+)
+
+// `marketSecDes` a `securityType2` can be found under. securityType2 values
+// missing from the table span several sectors and are not checked.
+var compatibleMarketSecDes = map[string][]string{
+	"Common Stock":       {"Equity"},
+	"Corp":               {"Corp"},
+	"Depositary Receipt": {"Equity"},
+	"Equity":             {"Equity"},
+	"Index":              {"Index"},
+	"Mtge":               {"Mtge"},
+	"Muni":               {"Muni"},
+	"Mutual Fund":        {"Equity"},
+	"Pool":               {"Mtge"},
+	"Preference":         {"Equity", "Pfd"},
+	"Warrant":            {"Equity"},
+	"Whole Loan":         {"Mtge"},
+}
+
+// Checks of [SetStrictValidation], on an otherwise valid item
+func (item *BaseItem) validateStrict() error {
+	if item.MarketSecDes != "" && item.SecurityType2 != "" {
+		sectors, ok := compatibleMarketSecDes[item.SecurityType2]
+		if ok && !slices.Contains(sectors, item.MarketSecDes) {
+			return fmt.Errorf("`securityType2` `%s` is not found under `marketSecDes` `%s`, expected one of %v",
+				item.SecurityType2, item.MarketSecDes, sectors)
+		}
+	}
+
+	// States issue municipal securities only
+	if item.StateCode != "" && item.MarketSecDes != "" && item.MarketSecDes != constants.MARKETSECDES_Muni {
+		return fmt.Errorf("`stateCode` only applies to `marketSecDes` `%s`, got `%s`",
+			constants.MARKETSECDES_Muni, item.MarketSecDes)
+	}
+
+	// Numeric intervals only narrow down a known kind of security
+	if item.SecurityType == "" && item.SecurityType2 == "" && item.MarketSecDes == "" {
+		for _, field := range []struct {
+			name string
+			set  bool
+		}{
+			{"strike", item.Strike != nil},
+			{"contractSize", item.ContractSize != nil},
+			{"coupon", item.Coupon != nil},
+		} {
+			if field.set {
+				return fmt.Errorf("`%s` needs `securityType`, `securityType2` or `marketSecDes` to give it meaning", field.name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package testutil
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/minh-dng/openfigi-go/internal/clock"
+)
+
+// Time source of openfigi's retry waits and rate limiting
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+}
+
+// Test hook: make openfigi read the time from c, e.g. a [FakeClock], so retries
+// and rate limiting run without real delays. nil restores the real clock.
+// The clock is global: tests using it must not run in parallel.
+//
+// Usage:
+//
+//	fake := testutil.NewFakeClock(time.Now())
+//	testutil.SetClock(fake)
+//	defer testutil.SetClock(nil)
+func SetClock(c Clock) {
+	clock.Set(c)
+}
+
+// [Clock] that only moves when slept on: Sleep and After advance it by their
+// duration at once and record it, instead of waiting
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) Sleep(d time.Duration) {
+	c.sleep(d)
+}
+
+// Sleep for d and return a channel already holding the new time
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.sleep(d)
+	return ch
+}
+
+// Move the clock forward by d, without recording a sleep
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(max(d, 0))
+}
+
+func (c *FakeClock) sleep(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(max(d, 0))
+	return c.now
+}
+
+// Durations slept on the clock so far, in order
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.sleeps)
+}
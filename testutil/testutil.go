@@ -0,0 +1,156 @@
+// Fake OpenFIGI server for testing code built on openfigi-go.
+//
+// Usage:
+//
+//	ts := testutil.NewMockServer(
+//		testutil.Fixture{Endpoint: "/mapping", IDType: "TICKER", IDValue: "IBM", Body: `{"data": [{"figi": "BBG000BLNNH6"}]}`},
+//		testutil.Fixture{Endpoint: "/search", Body: `{"data": [{"figi": "BBG000BLNNH6"}]}`},
+//	)
+//	defer ts.Close()
+//	openfigi.SetAPIBaseUrl(ts.URL)
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+// A canned response of the mock server
+type Fixture struct {
+	// Path answered, e.g. "/mapping", "/search", "/filter" or "/mapping/values/exchCode"
+	Endpoint string
+	// "/mapping" only: the job answered, matched on `idType` and `idValue`.
+	// Body is the job's object in the response array, e.g. `{"data": [...]}`.
+	IDType  string
+	IDValue string
+	// "/search" and "/filter" only: the page answered, matched on `start`
+	// ("" for the first page) and on `query` when not empty.
+	Start string
+	Query string
+	// Default 200
+	Status int
+	// JSON body
+	Body string
+}
+
+// Jobs per mapping request, as enforced by OpenFIGI
+const (
+	MaxJobsWithoutKey = 10
+	MaxJobsWithKey    = 100
+)
+
+// Body of a mapping job without fixture
+const noMatchJob = `{"warning": ["No identifier found."]}`
+
+// Server answering with the fixtures, the first match winning.
+// Mapping requests over the job limit, 10 without an `X-OPENFIGI-APIKEY`
+// header and 100 with one, get 413. Unmatched search and filter pages are empty;
+// other unmatched paths get 404. The caller closes the server.
+func NewMockServer(fixtures ...Fixture) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mapping":
+			serveMapping(w, r, fixtures)
+		case "/search", "/filter":
+			servePage(w, r, fixtures)
+		default:
+			for _, f := range fixtures {
+				if f.Endpoint == r.URL.Path {
+					write(w, f.Status, f.Body)
+					return
+				}
+			}
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func serveMapping(w http.ResponseWriter, r *http.Request, fixtures []Fixture) {
+	if !checkPost(w, r) {
+		return
+	}
+	var jobs []struct {
+		IDType  string `json:"idType"`
+		IDValue any    `json:"idValue"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&jobs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxJobs := MaxJobsWithoutKey
+	if r.Header.Get("X-OPENFIGI-APIKEY") != "" {
+		maxJobs = MaxJobsWithKey
+	}
+	if len(jobs) > maxJobs {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	res := make([]json.RawMessage, len(jobs))
+	for i, job := range jobs {
+		res[i] = json.RawMessage(noMatchJob)
+		for _, f := range fixtures {
+			if f.Endpoint == "/mapping" && f.IDType == job.IDType && f.IDValue == fmt.Sprint(job.IDValue) {
+				if f.Status != 0 && f.Status != http.StatusOK {
+					write(w, f.Status, f.Body)
+					return
+				}
+				res[i] = json.RawMessage(f.Body)
+				break
+			}
+		}
+	}
+	body, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	write(w, http.StatusOK, string(body))
+}
+
+func servePage(w http.ResponseWriter, r *http.Request, fixtures []Fixture) {
+	if !checkPost(w, r) {
+		return
+	}
+	var payload struct {
+		Query string `json:"query"`
+		Start string `json:"start"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, f := range fixtures {
+		if f.Endpoint == r.URL.Path && f.Start == payload.Start && (f.Query == "" || f.Query == payload.Query) {
+			write(w, f.Status, f.Body)
+			return
+		}
+	}
+	if r.URL.Path == "/filter" {
+		write(w, http.StatusOK, `{"data": [], "total": 0}`)
+	} else {
+		write(w, http.StatusOK, `{"data": []}`)
+	}
+}
+
+func checkPost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return false
+	}
+	if r.Header.Get("Content-Type") != "application/json" {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return false
+	}
+	return true
+}
+
+func write(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	if status != 0 {
+		w.WriteHeader(status)
+	}
+	w.Write([]byte(body))
+}
@@ -0,0 +1,112 @@
+package testutil_test
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	openfigi "github.com/minh-dng/openfigi-go"
+	"github.com/minh-dng/openfigi-go/constants"
+	"github.com/minh-dng/openfigi-go/testutil"
+)
+
+func TestMockServer(t *testing.T) {
+	ts := testutil.NewMockServer(
+		testutil.Fixture{Endpoint: "/mapping", IDType: constants.IDTYPE_TICKER, IDValue: "IBM", Body: `{"data": [{"figi": "BBG000BLNNH6"}]}`},
+		testutil.Fixture{Endpoint: "/search", Query: "IBM", Body: `{"data": [{"figi": "BBG000BLNNH6"}], "next": "2"}`},
+		testutil.Fixture{Endpoint: "/search", Start: "2", Body: `{"data": [{"figi": "BBG000BLNNJ4"}]}`},
+	)
+	defer ts.Close()
+
+	openfigi.SetAPIBaseUrl(ts.URL)
+	defer openfigi.ResetConfig()
+
+	t.Run("mapping", func(t *testing.T) {
+		ibm := openfigi.MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+		aapl := openfigi.MappingItem{Type: constants.IDTYPE_TICKER, Value: "AAPL"}
+		res, err := openfigi.MappingRequest{ibm, aapl}.Fetch()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(res) != 2 || len(res[0].Data) != 1 || res[0].Data[0].FIGI != "BBG000BLNNH6" {
+			t.Fatalf("Expected IBM's FIGI, got %+v", res)
+		}
+		if len(res[1].Warning) == 0 {
+			t.Errorf("Expected a warning for AAPL, got %+v", res[1])
+		}
+	})
+	t.Run("job limit", func(t *testing.T) {
+		ibm := openfigi.MappingItem{Type: constants.IDTYPE_TICKER, Value: "IBM"}
+		req := slices.Repeat(openfigi.MappingRequest{ibm}, testutil.MaxJobsWithoutKey+1)
+		_, err := req.Fetch()
+		var apiErr *openfigi.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected %d, got %v", http.StatusRequestEntityTooLarge, err)
+		}
+	})
+	t.Run("pages", func(t *testing.T) {
+		res, err := openfigi.BaseItem{}.Search("IBM", openfigi.FirstPage)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		next, err := res.Next()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(next.Data) != 1 || next.Data[0].FIGI != "BBG000BLNNJ4" {
+			t.Errorf("Expected the second page, got %+v", next.Data)
+		}
+	})
+}
+
+func TestFakeClock(t *testing.T) {
+	ts := testutil.NewMockServer(
+		testutil.Fixture{Endpoint: "/search", Status: http.StatusTooManyRequests, Body: `{}`},
+		testutil.Fixture{Endpoint: "/search", Start: "2", Body: `{"data": []}`},
+	)
+	defer ts.Close()
+	defer openfigi.ResetConfig()
+
+	t.Run("retries", func(t *testing.T) {
+		fake := testutil.NewFakeClock(time.Now())
+		testutil.SetClock(fake)
+		defer testutil.SetClock(nil)
+		if err := openfigi.Configure(openfigi.Config{BaseURL: ts.URL, MaxRetries: 3}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		start := time.Now()
+		_, err := openfigi.BaseItem{}.Search("IBM", openfigi.FirstPage)
+		var apiErr *openfigi.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("Expected %d, got %v", http.StatusTooManyRequests, err)
+		}
+		want := []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second}
+		if got := fake.Sleeps(); !slices.Equal(got, want) {
+			t.Errorf("Expected backoffs %v, got %v", want, got)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected no real delay, took %v", elapsed)
+		}
+	})
+	t.Run("rate limit", func(t *testing.T) {
+		fake := testutil.NewFakeClock(time.Now())
+		testutil.SetClock(fake)
+		defer testutil.SetClock(nil)
+		if err := openfigi.Configure(openfigi.Config{BaseURL: ts.URL, RateLimitPerMinute: 1}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for range 3 {
+			if _, err := (openfigi.BaseItem{}).Search("", "2"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+		want := []time.Duration{0, time.Minute, time.Minute}
+		if got := fake.Sleeps(); !slices.Equal(got, want) {
+			t.Errorf("Expected waits %v, got %v", want, got)
+		}
+	})
+}
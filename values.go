@@ -66,7 +66,6 @@ var exchCodeSet = sets.New(
 	"AP",
 	"APX",
 	"AQ",
-	"Aquis",
 	"AR",
 	"ARMENIA",
 	"AS",
@@ -83,6 +82,7 @@ var exchCodeSet = sets.New(
 	"AX",
 	"AY",
 	"AZ",
+	"Aquis",
 	"B1",
 	"B2",
 	"B3",
@@ -98,8 +98,6 @@ var exchCodeSet = sets.New(
 	"BATS",
 	"BB",
 	"BBOX",
-	"bbox",
-	"bbsp",
 	"BBX",
 	"BC",
 	"BCEX",
@@ -111,58 +109,44 @@ var exchCodeSet = sets.New(
 	"BELARUS",
 	"BELGRADE",
 	"BEQU",
-	"bequ",
 	"BERLIN",
 	"BERMUDA",
 	"BERN",
 	"BEVSA",
 	"BF",
 	"BFLY",
-	"bfly",
 	"BFNX",
-	"bfnx",
 	"BFO",
 	"BFRX",
-	"bfrx",
 	"BFX",
 	"BG",
 	"BGC",
 	"BGON",
-	"bgon",
 	"BH",
 	"BI",
 	"BIDS",
 	"BILBAO",
 	"BINC",
-	"binc",
 	"BITZ",
 	"BIVA",
 	"BJEX",
 	"BK",
 	"BL3P",
-	"blc2",
 	"BLCR",
-	"blcr",
 	"BM",
 	"BMF",
 	"BN",
 	"BNCE",
-	"bnce",
 	"BNDX",
 	"BNF",
 	"BNUS",
-	"bnus",
 	"BO",
-	"Bodiva",
 	"BOLSA CENTROAMER",
 	"BOLSA NACL VALOR",
-	"Bondvision",
 	"BORSA ISTANBUL",
 	"BOTSWANA",
 	"BOV",
 	"BP",
-	"Bpm",
-	"bpnd",
 	"BPVB",
 	"BQ",
 	"BR",
@@ -172,19 +156,12 @@ var exchCodeSet = sets.New(
 	"BSE",
 	"BT",
 	"BTBA",
-	"btba",
 	"BTBY",
 	"BTCA",
-	"btcb",
-	"bthb",
-	"btmx",
 	"BTRK",
-	"btrk",
 	"BTRX",
-	"btrx",
 	"BTS",
 	"BTSO",
-	"btso",
 	"BU",
 	"BUCHAREST",
 	"BUDAPEST",
@@ -199,6 +176,9 @@ var exchCodeSet = sets.New(
 	"BX - SWISS",
 	"BY",
 	"BZ",
+	"Bodiva",
+	"Bondvision",
+	"Bpm",
 	"C1",
 	"C2",
 	"C3",
@@ -212,10 +192,8 @@ var exchCodeSet = sets.New(
 	"CBO",
 	"CBOE",
 	"CBSE",
-	"cbse",
 	"CBT",
 	"CC",
-	"ccck",
 	"CCO",
 	"CCT",
 	"CCX",
@@ -225,7 +203,6 @@ var exchCodeSet = sets.New(
 	"CEG",
 	"CENT ANOTACIONE",
 	"CEXI",
-	"cexi",
 	"CF",
 	"CFF",
 	"CFLR",
@@ -233,7 +210,6 @@ var exchCodeSet = sets.New(
 	"CH",
 	"CHANNEL ISLANDS",
 	"CHI-X",
-	"Chi-X Australia",
 	"CHICAGO",
 	"CHINA INTERBANK",
 	"CHONGWA ASSET EX",
@@ -247,35 +223,30 @@ var exchCodeSet = sets.New(
 	"CMX",
 	"CN",
 	"CNEX",
-	"cnex",
 	"CNGG",
 	"CNMT",
 	"CNSX",
 	"CO",
 	"COLOMBIA",
 	"COLOMBO",
-	"cone",
 	"COP",
 	"CP",
 	"CQ",
 	"CR",
 	"CRCO",
-	"crco",
-	"crv2",
 	"CS",
 	"CSE",
 	"CT",
 	"CU",
 	"CUCY",
-	"cucy",
 	"CURV",
-	"curv",
 	"CV",
 	"CW",
 	"CX",
 	"CY",
 	"CYPRUS",
 	"CZ",
+	"Chi-X Australia",
 	"DAR-ES-SALAAM",
 	"DB",
 	"DBS Digital",
@@ -284,7 +255,6 @@ var exchCodeSet = sets.New(
 	"DD",
 	"DE",
 	"DEB",
-	"delt",
 	"DF",
 	"DFX",
 	"DG",
@@ -298,7 +268,6 @@ var exchCodeSet = sets.New(
 	"DME",
 	"DN",
 	"DOUALA",
-	"drbt",
 	"DS",
 	"DT",
 	"DU",
@@ -335,7 +304,6 @@ var exchCodeSet = sets.New(
 	"EQ",
 	"ERI",
 	"ERIS",
-	"eris",
 	"ES",
 	"ESWATINI",
 	"ET",
@@ -354,11 +322,11 @@ var exchCodeSet = sets.New(
 	"EUWAX STUTTGART",
 	"EUX",
 	"EX",
-	"Extra MOT",
-	"Extra MOT Pro",
 	"EXXA",
 	"EY",
 	"EZ",
+	"Extra MOT",
+	"Extra MOT Pro",
 	"FA",
 	"FEX",
 	"FF",
@@ -385,19 +353,16 @@ var exchCodeSet = sets.New(
 	"GE",
 	"GEMMA",
 	"GEORGIA",
-	"Gettex",
 	"GF",
 	"GG",
 	"GH",
 	"GHANA",
 	"GI",
-	"Gibraltar",
 	"GK",
 	"GL",
 	"GM",
 	"GME",
 	"GMNI",
-	"gmni",
 	"GN",
 	"GQ",
 	"GR",
@@ -409,6 +374,8 @@ var exchCodeSet = sets.New(
 	"GW",
 	"GY",
 	"GZ",
+	"Gettex",
+	"Gibraltar",
 	"H1",
 	"H2",
 	"HAMBURG",
@@ -421,7 +388,6 @@ var exchCodeSet = sets.New(
 	"HEX",
 	"HI-MTF",
 	"HITB",
-	"hitb",
 	"HK",
 	"HKG",
 	"HKM",
@@ -430,7 +396,6 @@ var exchCodeSet = sets.New(
 	"HO",
 	"HONG KONG",
 	"HUOB",
-	"huob",
 	"HX",
 	"I2",
 	"IA",
@@ -457,7 +422,6 @@ var exchCodeSet = sets.New(
 	"INCH",
 	"INDIA INX",
 	"INDONESIA EXCH",
-	"indr",
 	"INE",
 	"INTERCONTINENTAL",
 	"INX",
@@ -472,7 +436,6 @@ var exchCodeSet = sets.New(
 	"IST",
 	"IT",
 	"ITBI",
-	"itbi",
 	"IX",
 	"IY",
 	"JA",
@@ -509,7 +472,6 @@ var exchCodeSet = sets.New(
 	"KB",
 	"KCB",
 	"KCON",
-	"kcon",
 	"KE",
 	"KF",
 	"KFE",
@@ -518,13 +480,11 @@ var exchCodeSet = sets.New(
 	"KK",
 	"KL",
 	"KN",
-	"korb",
 	"KOREA",
 	"KOSDAQ",
 	"KP",
 	"KQ",
 	"KRKN",
-	"krkn",
 	"KS",
 	"KUWAIT",
 	"KX",
@@ -549,7 +509,6 @@ var exchCodeSet = sets.New(
 	"LISBON",
 	"LJUBLJANA",
 	"LMAX",
-	"lmax",
 	"LME",
 	"LMP",
 	"LN",
@@ -596,7 +555,6 @@ var exchCodeSet = sets.New(
 	"MERJ",
 	"MERVAL",
 	"MET",
-	"mexc",
 	"MEXICO",
 	"MF",
 	"MFA",
@@ -635,10 +593,10 @@ var exchCodeSet = sets.New(
 	"MTS AMSTERDAM",
 	"MTS Austria",
 	"MTS BELGIUM",
-	"MTS Finland",
 	"MTS FRANCE",
-	"MTS Germany",
+	"MTS Finland",
 	"MTS GREECE",
+	"MTS Germany",
 	"MTS IRELAND",
 	"MTS Israel",
 	"MTS PORTUGAL",
@@ -713,12 +671,11 @@ var exchCodeSet = sets.New(
 	"NSE INDIA",
 	"NSEL",
 	"NSEL 1î",
-	"NSEL=h*",
 	"NSEL=V:É",
+	"NSEL=h*",
 	"NSELß↓",
 	"NT",
 	"NV",
-	"nvdx",
 	"NW",
 	"NX",
 	"NY",
@@ -736,9 +693,7 @@ var exchCodeSet = sets.New(
 	"ODE",
 	"OF",
 	"OKCN",
-	"okcn",
 	"OKEX",
-	"okex",
 	"OM",
 	"OMEGA CANADA ATS",
 	"OMP",
@@ -747,7 +702,6 @@ var exchCodeSet = sets.New(
 	"OSAKA 2",
 	"OSE",
 	"OSLO",
-	"oslx",
 	"OTC BB",
 	"OTC US",
 	"OU",
@@ -770,7 +724,6 @@ var exchCodeSet = sets.New(
 	"PHL",
 	"PINK SHEETS",
 	"PK",
-	"pksp",
 	"PL",
 	"PLX",
 	"PM",
@@ -780,7 +733,6 @@ var exchCodeSet = sets.New(
 	"PNX",
 	"PO",
 	"POLO",
-	"polo",
 	"PORT MORESBY",
 	"PORTAL",
 	"PP",
@@ -801,13 +753,12 @@ var exchCodeSet = sets.New(
 	"QH",
 	"QM",
 	"QN",
-	"qsp3",
 	"QT",
 	"QU",
 	"QUITO",
 	"QUON",
-	"Quotrix",
 	"QX",
+	"Quotrix",
 	"RASDAQ",
 	"RB",
 	"RC",
@@ -891,27 +842,23 @@ var exchCodeSet = sets.New(
 	"SS",
 	"SSE",
 	"ST",
-	"St. Petersburg",
 	"STMP",
-	"stmp",
 	"STRASBOURG",
 	"STUTTGART",
 	"SU",
 	"SUSH",
-	"sush",
 	"SV",
 	"SW",
 	"SX",
 	"SXHA",
-	"sxha",
 	"SY",
 	"SZ",
+	"St. Petersburg",
 	"T1",
 	"T2",
 	"T3",
 	"TA",
 	"TAD",
-	"Taipei",
 	"TAIWAN",
 	"TASHKENT",
 	"TAV",
@@ -967,6 +914,7 @@ var exchCodeSet = sets.New(
 	"TX",
 	"TY",
 	"TZ",
+	"Taipei",
 	"UA",
 	"UB",
 	"UC",
@@ -988,16 +936,13 @@ var exchCodeSet = sets.New(
 	"UO",
 	"UP",
 	"UPBT",
-	"upbt",
 	"UQ",
 	"UR",
 	"URCEX",
 	"US",
 	"USE",
 	"USP2",
-	"usp2",
 	"USP3",
-	"usp3",
 	"UT",
 	"UU",
 	"UV",
@@ -1021,13 +966,13 @@ var exchCodeSet = sets.New(
 	"VL",
 	"VM",
 	"VN",
-	"Vorvel",
 	"VP",
 	"VR",
 	"VS",
 	"VU",
 	"VX",
 	"VY",
+	"Vorvel",
 	"WARSAW",
 	"WBA",
 	"WCE",
@@ -1070,15 +1015,12 @@ var exchCodeSet = sets.New(
 	"YELLOW SHEETS",
 	"YLX",
 	"YOBT",
-	"yobt",
 	"YSE",
 	"ZA",
 	"ZAGREB",
 	"ZAIF",
-	"zaif",
 	"ZB",
 	"ZBCN",
-	"zbcn",
 	"ZC",
 	"ZCE",
 	"ZG",
@@ -1087,6 +1029,64 @@ var exchCodeSet = sets.New(
 	"ZL",
 	"ZS",
 	"ZU",
+	"bbox",
+	"bbsp",
+	"bequ",
+	"bfly",
+	"bfnx",
+	"bfrx",
+	"bgon",
+	"binc",
+	"blc2",
+	"blcr",
+	"bnce",
+	"bnus",
+	"bpnd",
+	"btba",
+	"btcb",
+	"bthb",
+	"btmx",
+	"btrk",
+	"btrx",
+	"btso",
+	"cbse",
+	"ccck",
+	"cexi",
+	"cnex",
+	"cone",
+	"crco",
+	"crv2",
+	"cucy",
+	"curv",
+	"delt",
+	"drbt",
+	"eris",
+	"gmni",
+	"hitb",
+	"huob",
+	"indr",
+	"itbi",
+	"kcon",
+	"korb",
+	"krkn",
+	"lmax",
+	"mexc",
+	"nvdx",
+	"okcn",
+	"okex",
+	"oslx",
+	"pksp",
+	"polo",
+	"qsp3",
+	"stmp",
+	"sush",
+	"sxha",
+	"upbt",
+	"usp2",
+	"usp3",
+	"yobt",
+	"zaif",
+	"zbcn",
 )
 
 var micCodeSet = sets.New(
@@ -1885,13 +1885,13 @@ var currencySet = sets.New(
 	"ZMK",
 	"ZMW",
 	"ZWD",
-	"ZWd",
 	"ZWF",
 	"ZWG",
-	"ZWg",
 	"ZWL",
 	"ZWN",
 	"ZWR",
+	"ZWd",
+	"ZWg",
 )
 
 var marketSecDesSet = sets.New(
@@ -1918,17 +1918,7 @@ var securityTypeSet = sets.New(
 	"ADJUSTABLE",
 	"ADJUSTABLE, OID",
 	"ADR",
-	"Agncy ABS Home",
-	"Agncy ABS Other",
-	"Agncy CMBS",
-	"Agncy CMO FLT",
-	"Agncy CMO INV",
-	"Agncy CMO IO",
-	"Agncy CMO Other",
-	"Agncy CMO PO",
-	"Agncy CMO Z",
 	"ASSET-BASED",
-	"Asset-Based",
 	"ASSET-BASED BRIDGE",
 	"ASSET-BASED BRIDGE REV",
 	"ASSET-BASED BRIDGE TERM",
@@ -1944,6 +1934,16 @@ var securityTypeSet = sets.New(
 	"AUSTRALIAN",
 	"AUSTRALIAN CD",
 	"AUSTRALIAN CP",
+	"Agncy ABS Home",
+	"Agncy ABS Other",
+	"Agncy CMBS",
+	"Agncy CMO FLT",
+	"Agncy CMO INV",
+	"Agncy CMO IO",
+	"Agncy CMO Other",
+	"Agncy CMO PO",
+	"Agncy CMO Z",
+	"Asset-Based",
 	"Austrian Crt",
 	"BANK ACCEPT BILL",
 	"BANK BILL",
@@ -1952,14 +1952,11 @@ var securityTypeSet = sets.New(
 	"BANKERS ACCEPTANCE",
 	"BASIS SWAP",
 	"BASIS TRADE ON CLOSE",
-	"Basket WRT",
 	"BDR",
 	"BEARER DEP NOTE",
-	"Belgium Cert",
 	"BELGIUM CP",
 	"BILL OF EXCHANGE",
 	"BILLET A ORDRE",
-	"Bond",
 	"BRAZIL GENERIC",
 	"BRAZILIAN CDI",
 	"BRIDGE",
@@ -1981,18 +1978,17 @@ var securityTypeSet = sets.New(
 	"BRIDGE VAT-TRNCH",
 	"BULLDOG",
 	"BUTTERFLY SWAP",
+	"Basket WRT",
+	"Belgium Cert",
+	"Bond",
 	"CAD INT BEAR CP",
 	"CALC_INSTRUMENT",
-	"Calendar Spread Option",
 	"CALL LOANS",
 	"CALLABLE CP",
 	"CANADIAN",
-	"Canadian",
 	"CANADIAN CD",
 	"CANADIAN CP",
-	"Canadian DR",
 	"CAPS & FLOORS",
-	"Car Forward",
 	"CASH",
 	"CASH FLOW",
 	"CASH FLOW, OID",
@@ -2005,31 +2001,35 @@ var securityTypeSet = sets.New(
 	"CF",
 	"CHILEAN CD",
 	"CHILEAN DN",
-	"Closed-End Fund",
 	"CMBS",
-	"Cmdt Fut WRT",
-	"Cmdt Idx WRT",
 	"COLLAT CALL NOTE",
 	"COLOMBIAN CD",
 	"COMMERCIAL NOTE",
 	"COMMERCIAL PAPER",
-	"Commodity Index",
-	"Common Stock",
 	"CONTRACT FOR DIFFERENCE",
 	"CONTRACT FRA",
+	"CP-LIKE EXT NOTE",
+	"CPI LINKED",
+	"CROSS",
+	"CURVE_ROLL",
+	"Calendar Spread Option",
+	"Canadian",
+	"Canadian DR",
+	"Car Forward",
+	"Closed-End Fund",
+	"Cmdt Fut WRT",
+	"Cmdt Idx WRT",
+	"Commodity Index",
+	"Common Stock",
 	"Conv Bond",
 	"Conv Prfd",
 	"Corp Bnd WRT",
 	"Cover Pool",
-	"CP-LIKE EXT NOTE",
-	"CPI LINKED",
-	"CROSS",
 	"Crypto",
+	"Currency WRT",
 	"Currency future.",
 	"Currency option.",
 	"Currency spot.",
-	"Currency WRT",
-	"CURVE_ROLL",
 	"DELAY-DRAW",
 	"DELAY-DRAW ISLAMIC",
 	"DELAY-DRAW ISLAMIC LOC",
@@ -2062,12 +2062,9 @@ var securityTypeSet = sets.New(
 	"DOMESTC TIME DEP",
 	"DOMESTIC",
 	"DOMESTIC MTN",
-	"Dutch Cert",
 	"DUTCH CP",
+	"Dutch Cert",
 	"EDR",
-	"Equity Index",
-	"Equity Option",
-	"Equity WRT",
 	"ETP",
 	"EURO CD",
 	"EURO CP",
@@ -2079,46 +2076,49 @@ var securityTypeSet = sets.New(
 	"EURO-ZONE",
 	"EXTEND COMM NOTE",
 	"EXTEND. NOTE MTN",
+	"Equity Index",
+	"Equity Option",
+	"Equity WRT",
 	"FDIC",
 	"FED FUNDS",
 	"FIDC",
-	"Financial commodity future.",
-	"Financial commodity generic.",
-	"Financial commodity option.",
-	"Financial commodity spot.",
-	"Financial index future.",
-	"Financial index generic.",
-	"Financial index option.",
 	"FINNISH CD",
 	"FINNISH CP",
 	"FIXED",
-	"Fixed Income Index",
 	"FIXED, OID",
 	"FIXING RATE",
 	"FLOATING",
 	"FLOATING CP",
 	"FLOATING, OID",
 	"FNMA FHAVA",
-	"Foreign Sh.",
 	"FORWARD",
 	"FORWARD CROSS",
 	"FORWARD CURVE",
 	"FRA",
 	"FRENCH CD",
-	"French Cert",
 	"FRENCH CP",
-	"Fund of Funds",
-	"Futures Monthly Ticker",
 	"FWD SWAP",
 	"FX Curve",
 	"FX DISCOUNT NOTE",
+	"Financial commodity future.",
+	"Financial commodity generic.",
+	"Financial commodity option.",
+	"Financial commodity spot.",
+	"Financial index future.",
+	"Financial index generic.",
+	"Financial index option.",
+	"Fixed Income Index",
+	"Foreign Sh.",
+	"French Cert",
+	"Fund of Funds",
+	"Futures Monthly Ticker",
 	"GDR",
-	"Generic currency future.",
-	"Generic index future.",
-	"German Cert",
 	"GERMAN CP",
 	"GLOBAL",
 	"GUARANTEE FAC",
+	"Generic currency future.",
+	"Generic index future.",
+	"German Cert",
 	"HB",
 	"HDR",
 	"HONG KONG CD",
@@ -2127,16 +2127,11 @@ var securityTypeSet = sets.New(
 	"IDR",
 	"IMM FORWARD",
 	"IMM SWAP",
-	"Index",
-	"Index Option",
-	"Index WRT",
 	"INDIAN CD",
 	"INDIAN CP",
 	"INDONESIAN CP",
-	"Indx Fut WRT",
 	"INFLATION SWAP",
 	"INT BEAR FIXBIS",
-	"Int. Rt. WRT",
 	"INTER. APPRECIATION",
 	"INTER. APPRECIATION, OID",
 	"ISLAMIC",
@@ -2152,6 +2147,11 @@ var securityTypeSet = sets.New(
 	"ISLAMIC TERM GUARANTEE FAC",
 	"ISLAMIC TERM VAT-TRNCH",
 	"ISLAMIC VAT-TRNCH",
+	"Index",
+	"Index Option",
+	"Index WRT",
+	"Indx Fut WRT",
+	"Int. Rt. WRT",
 	"JUMBO CD",
 	"KOREAN CD",
 	"KOREAN CP",
@@ -2162,7 +2162,6 @@ var securityTypeSet = sets.New(
 	"LOC TERM",
 	"Ltd Part",
 	"MALAYSIAN CP",
-	"Managed Account",
 	"MARGIN TERM DEP",
 	"MASTER NOTES",
 	"MBS 10yr",
@@ -2175,14 +2174,13 @@ var securityTypeSet = sets.New(
 	"MBS 5yr",
 	"MBS 7yr",
 	"MBS ARM",
-	"MBS balloon",
 	"MBS Other",
+	"MBS balloon",
 	"MED TERM NOTE",
 	"MEDIUM TERM CD",
 	"MEDIUM TERM ECD",
 	"MEXICAN CP",
 	"MEXICAN PAGARE",
-	"Misc.",
 	"MLP",
 	"MONETARY BILLS",
 	"MONEY MARKET CALL",
@@ -2190,9 +2188,11 @@ var securityTypeSet = sets.New(
 	"MUNI INT BEAR CP",
 	"MUNI SWAP",
 	"MURABAHA",
-	"Mutual Fund",
 	"MV",
 	"MX CERT BURSATIL",
+	"Managed Account",
+	"Misc.",
+	"Mutual Fund",
 	"NDF SWAP",
 	"NEG EURO CP",
 	"NEG INST DEPOSIT",
@@ -2206,24 +2206,16 @@ var securityTypeSet = sets.New(
 	"OID",
 	"ONSHORE FORWARD",
 	"ONSHORE SWAP",
-	"Open-End Fund",
 	"OPTION",
-	"Option on Equity Future",
 	"OPTION VOLATILITY",
 	"OTHER",
 	"OVER/NIGHT",
 	"OVERDRAFT",
 	"OVERNIGHT INDEXED SWAP",
+	"Open-End Fund",
+	"Option on Equity Future",
 	"PANAMANIAN CP",
-	"Participate Cert",
 	"PHILIPPINE CP",
-	"Physical commodity forward.",
-	"Physical commodity future.",
-	"Physical commodity generic.",
-	"Physical commodity option.",
-	"Physical commodity spot.",
-	"Physical index future.",
-	"Physical index option.",
 	"PIK",
 	"PIK LOC",
 	"PIK REV",
@@ -2231,16 +2223,25 @@ var securityTypeSet = sets.New(
 	"PIK TERM",
 	"PLAZOS FIJOS",
 	"PORTUGUESE CP",
-	"Preference",
-	"Preferred",
 	"PRES",
-	"Prfd WRT",
 	"PRIV PLACEMENT",
 	"PRIVATE",
-	"Private Comp",
-	"Private-equity backed",
 	"PROMISSORY NOTE",
 	"PROV T-BILL",
+	"PUBLIC",
+	"Participate Cert",
+	"Physical commodity forward.",
+	"Physical commodity future.",
+	"Physical commodity generic.",
+	"Physical commodity option.",
+	"Physical commodity spot.",
+	"Physical index future.",
+	"Physical index option.",
+	"Preference",
+	"Preferred",
+	"Prfd WRT",
+	"Private Comp",
+	"Private-equity backed",
 	"Prvt CMBS",
 	"Prvt CMO FLT",
 	"Prvt CMO INV",
@@ -2248,10 +2249,8 @@ var securityTypeSet = sets.New(
 	"Prvt CMO Other",
 	"Prvt CMO PO",
 	"Prvt CMO Z",
-	"PUBLIC",
 	"Pvt Eqty Fund",
 	"RDC",
-	"Receipt",
 	"REIT",
 	"REPO",
 	"RESERVE-BASED DIP REV",
@@ -2263,22 +2262,19 @@ var securityTypeSet = sets.New(
 	"REV",
 	"REV GUARANTEE FAC",
 	"REV VAT-TRNCH",
+	"Receipt",
 	"Revolver",
 	"Right",
 	"Royalty Trst",
 	"S.TERM LOAN NOTE",
 	"SAMURAI",
-	"Savings Plan",
-	"Savings Share",
 	"SBA Pool",
 	"SDR",
 	"SEC GEN COLL NOT",
-	"Sec Lending",
 	"SHOGUN",
 	"SHORT TERM BN",
 	"SHORT TERM DN",
 	"SINGAPORE CP",
-	"Singapore DR",
 	"SINGLE STOCK DIVIDEND FUTURE",
 	"SINGLE STOCK FORWARD",
 	"SINGLE STOCK FUTURE",
@@ -2287,25 +2283,29 @@ var securityTypeSet = sets.New(
 	"SPANISH CP",
 	"SPECIAL LMMK PGM",
 	"SPOT",
-	"Spot index.",
 	"STANDBY",
 	"STANDBY LOC",
 	"STANDBY LOC GUARANTEE FAC",
 	"STANDBY REV",
 	"STANDBY TERM",
-	"Stapled Security",
 	"STERLING CD",
 	"STERLING CP",
-	"Strategy Trade.",
 	"SWAP",
 	"SWAP SPREAD",
 	"SWAPTION VOLATILITY",
 	"SWEDISH CP",
 	"SWINGLINE",
-	"Swiss Cert",
 	"SYNTH LOC",
 	"SYNTH REV",
 	"SYNTH TERM",
+	"Savings Plan",
+	"Savings Share",
+	"Sec Lending",
+	"Singapore DR",
+	"Spot index.",
+	"Stapled Security",
+	"Strategy Trade.",
+	"Swiss Cert",
 	"Synthetic Term",
 	"TAIWAN CP",
 	"TAIWAN CP GUAR",
@@ -2315,23 +2315,21 @@ var securityTypeSet = sets.New(
 	"TAX CREDIT, OID",
 	"TDR",
 	"TERM",
-	"Term",
 	"TERM DEPOSITS",
 	"TERM GUARANTEE FAC",
 	"TERM REV",
 	"TERM VAT-TRNCH",
 	"THAILAND CP",
 	"TLTRO TERM",
-	"Tracking Stk",
 	"TREASURY BILL",
+	"Term",
+	"Tracking Stk",
 	"U.S. CD",
 	"U.S. CP",
 	"U.S. INT BEAR CP",
 	"UIT",
 	"UK GILT STOCK",
 	"UMBS MBS Other",
-	"Unit",
-	"Unit Inv Tst",
 	"UNITRANCHE",
 	"UNITRANCHE ASSET-BASED REV",
 	"UNITRANCHE DELAY-DRAW PIK T",
@@ -2342,6 +2340,8 @@ var securityTypeSet = sets.New(
 	"US DOMESTIC",
 	"US GOVERNMENT",
 	"US NON-DOLLAR",
+	"Unit",
+	"Unit Inv Tst",
 	"VAR RATE DEM OBL",
 	"VAT-TRNCH",
 	"VENEZUELAN CP",
@@ -2364,12 +2364,14 @@ var securityType2Set = sets.New(
 	"ABS/HG",
 	"ABS/MEZZ",
 	"BA",
-	"Bagged Briquettes",
-	"Bagged Pellets",
 	"BANK BILL",
 	"BANKERS ACCEPTANCE",
 	"BASIS SWAP",
 	"BASIS_IMM",
+	"BN",
+	"BUTTERFLY SWAP",
+	"Bagged Briquettes",
+	"Bagged Pellets",
 	"Bill",
 	"Billet 20MN",
 	"Billet 3803p",
@@ -2390,41 +2392,39 @@ var securityType2Set = sets.New(
 	"Billet LME Grade 8",
 	"Billet LME Grade 9",
 	"Billet Q235",
-	"BN",
 	"Bond",
 	"Bond/Note",
 	"Briquettes",
-	"BUTTERFLY SWAP",
 	"CAPFLOOR",
 	"CAPS & FLOORS",
 	"CASH RATE",
-	"Cathodes",
-	"Cathodes 100x100mm",
-	"Cathodes 25x25mm",
-	"Cathodes 50x50mm",
 	"CD",
 	"CDO2",
 	"CDS",
 	"CDS(CRP)",
-	"Certificate",
 	"CMBS",
 	"CMO",
-	"Coarse Grain Powder",
-	"Comdty",
 	"COMMERCIAL PAPER",
-	"Common Stock",
 	"CONTRACT FRA",
-	"Corp",
 	"CP",
 	"CRE",
 	"CROSS",
 	"CRYPTO",
+	"Cathodes",
+	"Cathodes 100x100mm",
+	"Cathodes 25x25mm",
+	"Cathodes 50x50mm",
+	"Certificate",
+	"Coarse Grain Powder",
+	"Comdty",
+	"Common Stock",
+	"Corp",
 	"Curncy",
-	"Daily Future",
 	"DEPOSIT",
+	"DN",
+	"Daily Future",
 	"Depositary Receipt",
 	"Derived",
-	"DN",
 	"Equity",
 	"FDIC",
 	"FIXED_FLOAT",
@@ -2434,92 +2434,92 @@ var securityType2Set = sets.New(
 	"FORWARD CROSS",
 	"FORWARD CURVE",
 	"FRA",
-	"Full Plate Cathodes",
-	"Future",
 	"FWD SWAP",
 	"FX Curve",
+	"Full Plate Cathodes",
+	"Future",
 	"Generic",
 	"Govt",
 	"Granules",
-	"Hedged",
 	"HF",
 	"HY",
+	"Hedged",
 	"IG",
 	"IMM FORWARD",
 	"IMM SWAP",
-	"Index",
-	"INFL_FIXING_ZERO_COUPON",
-	"INFL_FXFL_ZERO_COUPON",
 	"INFLATION SWAP",
 	"INFLATION_SWAP",
+	"INFL_FIXING_ZERO_COUPON",
+	"INFL_FXFL_ZERO_COUPON",
+	"Index",
 	"Ingots",
 	"Ingots 226/DIN",
 	"Ingots A380.1",
 	"Ingots AD12.1",
 	"Ingots D12S/J1S",
 	"Jumbo",
+	"LL",
+	"LL08",
 	"Large Sows",
 	"Large sows 226",
 	"Large sows A380.1",
 	"Large sows AD12.1",
 	"Large sows D12S",
-	"LL",
-	"LL08",
 	"M-Mkt",
 	"MAC SWAP",
 	"MEZZ",
 	"MML",
-	"Molybdenum Cntd n RMC(Roasted",
 	"MONEY MARKET CALL",
-	"Mtge",
 	"MTN",
-	"Muni",
 	"MUNI SWAP",
+	"Molybdenum Cntd n RMC(Roasted",
+	"Mtge",
+	"Muni",
 	"Mutual Fund",
 	"NDF SWAP",
-	"Nickel Rounds",
-	"Nickel Rounds Bag",
 	"NON-DELIVERABLE FORWARD",
 	"NON-DELIVERABLE IRS SWAP",
 	"NON-DELIVERABLE OIS SWAP",
+	"Nickel Rounds",
+	"Nickel Rounds Bag",
 	"Note",
 	"ONSHORE FORWARD",
 	"ONSHORE SWAP",
-	"Option",
 	"OPTION VOLATILITY",
 	"OTHER",
 	"OVERNIGHT INDEXED SWAP",
+	"Option",
 	"PAIR",
-	"Partnership Shares",
-	"Pellets",
-	"Pool",
 	"PP12",
 	"PP20",
 	"PP25",
 	"PP3.5",
+	"PROMISSORY NOTE",
+	"PROPERTY SWAP",
+	"Partnership Shares",
+	"Pellets",
+	"Pool",
 	"Preference",
 	"Preferred Stock",
-	"PROMISSORY NOTE",
 	"Prompt Forward",
-	"PROPERTY SWAP",
 	"QUARTERLY SWAP",
 	"REIT",
 	"REPO",
 	"RETURN IDX",
-	"Right",
 	"RMBS",
+	"Right",
 	"Rounds",
+	"SME",
+	"SPOT",
+	"SWAP",
+	"SWAP SPREAD",
+	"SWAPTION VOLATILITY",
 	"Small Sows",
 	"Small sows 226",
 	"Small sows A380.1",
 	"Small sows AD12.1",
 	"Small sows D12S",
-	"SME",
 	"Sows",
-	"SPOT",
-	"SWAP",
-	"SWAP SPREAD",
-	"SWAPTION VOLATILITY",
 	"T-Bar",
 	"T-Bars 226",
 	"T-Bars A380.1",